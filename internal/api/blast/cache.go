@@ -0,0 +1,304 @@
+package blast
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+)
+
+// CacheTTLs configures how long cached responses are considered fresh
+type CacheTTLs struct {
+	// Circuits applies to the tournament list of a circuit
+	Circuits time.Duration
+	// Finished applies to tournaments and matches where every series is completed
+	Finished time.Duration
+	// Live applies to everything that may still change
+	Live time.Duration
+}
+
+// DefaultCacheTTLs are the TTLs used when none are configured
+var DefaultCacheTTLs = CacheTTLs{
+	Circuits: time.Hour,
+	Finished: 30 * 24 * time.Hour,
+	Live:     30 * time.Second,
+}
+
+// CacheStatusHeader is set on responses passing through a CacheTransport
+// to report whether they were served from the cache
+const CacheStatusHeader = "X-Rlcs-Cache"
+
+// Cache status values reported in CacheStatusHeader
+const (
+	CacheHit         = "hit"
+	CacheRevalidated = "revalidated"
+	CacheMiss        = "miss"
+)
+
+// DefaultCacheDir returns the directory used for cached responses
+func DefaultCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to determine user cache dir: %w", err)
+	}
+	return filepath.Join(dir, "rlcs-cli"), nil
+}
+
+// CacheTransport is an http.RoundTripper that stores successful GET responses
+// on disk and revalidates stale entries with ETag/If-Modified-Since
+type CacheTransport struct {
+	// Dir is the directory cache entries are stored in
+	Dir string
+	// TTLs configures freshness per endpoint
+	TTLs CacheTTLs
+	// Refresh forces revalidation of every entry regardless of its freshness
+	Refresh bool
+	// Next performs the actual requests; nil uses http.DefaultTransport
+	Next http.RoundTripper
+
+	// now is a function that returns the current time, can be overridden for testing
+	now func() time.Time
+}
+
+// NewCacheTransport creates a cache transport storing entries in dir
+func NewCacheTransport(dir string, next http.RoundTripper) *CacheTransport {
+	return &CacheTransport{
+		Dir:  dir,
+		TTLs: DefaultCacheTTLs,
+		Next: next,
+	}
+}
+
+// cacheEntry is the on-disk representation of a cached response
+type cacheEntry struct {
+	URL          string      `json:"url"`
+	StoredAt     time.Time   `json:"storedAt"`
+	ExpiresAt    time.Time   `json:"expiresAt"`
+	ETag         string      `json:"etag,omitempty"`
+	LastModified string      `json:"lastModified,omitempty"`
+	Header       http.Header `json:"header"`
+	Body         []byte      `json:"body"`
+}
+
+func (t *CacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return t.next().RoundTrip(req)
+	}
+
+	now := t.currentTime()
+	url := req.URL.String()
+	path := t.entryPath(url)
+
+	entry, cached := t.load(path)
+	if cached && !t.Refresh && now.Before(entry.ExpiresAt) {
+		return entry.response(req, CacheHit), nil
+	}
+
+	outReq := req
+	if cached && (entry.ETag != "" || entry.LastModified != "") {
+		outReq = req.Clone(req.Context())
+		if entry.ETag != "" {
+			outReq.Header.Set("If-None-Match", entry.ETag)
+		}
+		if entry.LastModified != "" {
+			outReq.Header.Set("If-Modified-Since", entry.LastModified)
+		}
+	}
+
+	resp, err := t.next().RoundTrip(outReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && cached {
+		resp.Body.Close()
+		entry.StoredAt = now
+		entry.ExpiresAt = now.Add(t.ttl(req, entry.Body))
+		t.store(path, entry)
+		return entry.response(req, CacheRevalidated), nil
+	}
+
+	if resp.Header == nil {
+		resp.Header = http.Header{}
+	}
+	resp.Header.Set(CacheStatusHeader, CacheMiss)
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	t.store(path, &cacheEntry{
+		URL:          url,
+		StoredAt:     now,
+		ExpiresAt:    now.Add(t.ttl(req, body)),
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		Header:       http.Header{"Content-Type": resp.Header.Values("Content-Type")},
+		Body:         body,
+	})
+
+	return resp, nil
+}
+
+func (t *CacheTransport) next() http.RoundTripper {
+	if t.Next == nil {
+		return http.DefaultTransport
+	}
+	return t.Next
+}
+
+func (t *CacheTransport) currentTime() time.Time {
+	if t.now == nil {
+		return time.Now()
+	}
+	return t.now()
+}
+
+func (t *CacheTransport) entryPath(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return filepath.Join(t.Dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (t *CacheTransport) load(path string) (*cacheEntry, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false
+	}
+	return &entry, true
+}
+
+// store writes an entry atomically. Failures are ignored since the cache is
+// only an optimization and the response has already been received.
+func (t *CacheTransport) store(path string, entry *cacheEntry) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(t.Dir, 0o755); err != nil {
+		return
+	}
+	tmp, err := os.CreateTemp(t.Dir, ".tmp-*")
+	if err != nil {
+		return
+	}
+	_, writeErr := tmp.Write(data)
+	closeErr := tmp.Close()
+	if writeErr != nil || closeErr != nil {
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+// ttl picks the freshness lifetime for a response based on its endpoint and
+// whether the data it contains can still change
+func (t *CacheTransport) ttl(req *http.Request, body []byte) time.Duration {
+	path := req.URL.Path
+	switch {
+	case strings.Contains(path, "/circuits/") && strings.HasSuffix(path, "/tournaments"):
+		return t.TTLs.Circuits
+	case strings.HasSuffix(path, "/matches"):
+		if matchesFinished(body) {
+			return t.TTLs.Finished
+		}
+	case strings.HasSuffix(path, "/brackets"):
+		if bracketsFinished(body) {
+			return t.TTLs.Finished
+		}
+	case strings.HasSuffix(path, "/detailed"):
+		if matchesFinished(append(append([]byte("["), body...), ']')) {
+			return t.TTLs.Finished
+		}
+	}
+	return t.TTLs.Live
+}
+
+func (e *cacheEntry) response(req *http.Request, status string) *http.Response {
+	header := e.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Set(CacheStatusHeader, status)
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+// matchesFinished reports whether every match in a matches response has no
+// map still in progress and a team has won the majority of its best-of. A
+// series between two games has only ended maps, so its score decides it.
+func matchesFinished(body []byte) bool {
+	var matches []struct {
+		Type       string `json:"type"`
+		TeamAScore int    `json:"teamAScore"`
+		TeamBScore int    `json:"teamBScore"`
+		Maps       []struct {
+			StartedAt string `json:"startedAt"`
+			EndedAt   string `json:"endedAt"`
+		} `json:"maps"`
+	}
+	if err := json.Unmarshal(body, &matches); err != nil || len(matches) == 0 {
+		return false
+	}
+	for _, m := range matches {
+		for _, mp := range m.Maps {
+			if mp.StartedAt != "" && mp.EndedAt == "" {
+				return false
+			}
+		}
+		match := domain.Match{Type: m.Type, TeamAScore: m.TeamAScore, TeamBScore: m.TeamBScore}
+		if !match.IsDecided() {
+			return false
+		}
+	}
+	return true
+}
+
+// bracketsFinished reports whether every match in a brackets response is completed
+func bracketsFinished(body []byte) bool {
+	var brackets []struct {
+		Matches []struct {
+			IsCompleted bool `json:"isCompleted"`
+		} `json:"matches"`
+	}
+	if err := json.Unmarshal(body, &brackets); err != nil || len(brackets) == 0 {
+		return false
+	}
+	for _, b := range brackets {
+		for _, m := range b.Matches {
+			if !m.IsCompleted {
+				return false
+			}
+		}
+	}
+	return true
+}
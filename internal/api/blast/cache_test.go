@@ -0,0 +1,149 @@
+package blast
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheTransport_FreshAndRevalidate(t *testing.T) {
+	requests := 0
+	revalidations := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			revalidations++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`[{"id":"t1"}]`))
+	}))
+	defer server.Close()
+
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	transport := NewCacheTransport(t.TempDir(), nil)
+	transport.now = func() time.Time { return now }
+	client := NewClient(WithBaseURL(server.URL), WithTransport(transport))
+	ctx := context.Background()
+
+	_, err := client.ListTournaments(ctx, "2026")
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+
+	// Fresh entry is served from disk
+	now = now.Add(30 * time.Minute)
+	tournaments, err := client.ListTournaments(ctx, "2026")
+	require.NoError(t, err)
+	require.Len(t, tournaments, 1)
+	assert.Equal(t, 1, requests)
+
+	// Stale entry is revalidated
+	now = now.Add(2 * time.Hour)
+	tournaments, err = client.ListTournaments(ctx, "2026")
+	require.NoError(t, err)
+	require.Len(t, tournaments, 1)
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 1, revalidations)
+
+	// Refresh revalidates even fresh entries
+	transport.Refresh = true
+	_, err = client.ListTournaments(ctx, "2026")
+	require.NoError(t, err)
+	assert.Equal(t, 3, requests)
+}
+
+func TestCacheTransport_DoesNotCacheErrors(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL), WithTransport(NewCacheTransport(t.TempDir(), nil)), WithRetry(0, 0))
+
+	_, err := client.TournamentMatches(context.Background(), "t1")
+	assert.Error(t, err)
+	_, err = client.TournamentMatches(context.Background(), "t1")
+	assert.Error(t, err)
+	assert.Equal(t, 2, requests)
+}
+
+func TestCacheTransport_ttl(t *testing.T) {
+	transport := NewCacheTransport(t.TempDir(), nil)
+
+	tests := []struct {
+		name     string
+		path     string
+		body     string
+		expected time.Duration
+	}{
+		{
+			name:     "circuit tournaments",
+			path:     "/v2/circuits/2026/tournaments",
+			body:     `[]`,
+			expected: DefaultCacheTTLs.Circuits,
+		},
+		{
+			name:     "finished tournament matches",
+			path:     "/v2/games/rl/tournaments/t1/matches",
+			body:     `[{"type":"BO3","teamAScore":2,"teamBScore":0,"maps":[{"startedAt":"x","endedAt":"y"},{"startedAt":"x","endedAt":"y"},{"startedAt":""}]}]`,
+			expected: DefaultCacheTTLs.Finished,
+		},
+		{
+			name:     "tournament with upcoming match",
+			path:     "/v2/games/rl/tournaments/t1/matches",
+			body:     `[{"type":"BO1","teamAScore":1,"maps":[{"startedAt":"x","endedAt":"y"}]},{"type":"BO1","maps":[]}]`,
+			expected: DefaultCacheTTLs.Live,
+		},
+		{
+			name:     "series between games",
+			path:     "/v2/games/rl/tournaments/t1/matches",
+			body:     `[{"type":"BO5","teamAScore":2,"teamBScore":1,"maps":[{"startedAt":"x","endedAt":"y"},{"startedAt":"x","endedAt":"y"},{"startedAt":"x","endedAt":"y"},{"startedAt":""}]}]`,
+			expected: DefaultCacheTTLs.Live,
+		},
+		{
+			name:     "match of unknown length",
+			path:     "/v2/games/rl/tournaments/t1/matches",
+			body:     `[{"teamAScore":3,"maps":[{"startedAt":"x","endedAt":"y"}]}]`,
+			expected: DefaultCacheTTLs.Live,
+		},
+		{
+			name:     "finished match detail",
+			path:     "/v2/matches/m1/detailed",
+			body:     `{"type":"BO5","teamAScore":1,"teamBScore":3,"maps":[{"startedAt":"x","endedAt":"y"}]}`,
+			expected: DefaultCacheTTLs.Finished,
+		},
+		{
+			name:     "match detail between games",
+			path:     "/v2/matches/m1/detailed",
+			body:     `{"type":"BO5","teamAScore":1,"teamBScore":2,"maps":[{"startedAt":"x","endedAt":"y"}]}`,
+			expected: DefaultCacheTTLs.Live,
+		},
+		{
+			name:     "live match detail",
+			path:     "/v2/matches/m1/detailed",
+			body:     `{"maps":[{"startedAt":"x","endedAt":""}]}`,
+			expected: DefaultCacheTTLs.Live,
+		},
+		{
+			name:     "completed brackets",
+			path:     "/v2/games/rl/tournaments/t1/brackets",
+			body:     `[{"matches":[{"isCompleted":true}]}]`,
+			expected: DefaultCacheTTLs.Finished,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "https://api.blast.tv"+tt.path, nil)
+			assert.Equal(t, tt.expected, transport.ttl(req, []byte(tt.body)))
+		})
+	}
+}
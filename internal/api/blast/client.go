@@ -1,4 +1,356 @@
 package blast
 
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
 // BaseURL is the base URL for the Blast.tv API
 const BaseURL = "https://api.blast.tv/v2"
+
+// DefaultTimeout is the default timeout for a single API request
+const DefaultTimeout = 10 * time.Second
+
+// DefaultUserAgent is sent when no User-Agent has been configured
+const DefaultUserAgent = "rlcs-cli"
+
+// DefaultMaxRetries is the default number of retries for transient failures
+const DefaultMaxRetries = 3
+
+// DefaultRetryBaseDelay is the default delay before the first retry
+const DefaultRetryBaseDelay = 500 * time.Millisecond
+
+// maxRetryDelay caps the delay between two attempts, including Retry-After
+const maxRetryDelay = 30 * time.Second
+
+// Client is a client for the Blast.tv API endpoints used by the CLI
+type Client struct {
+	baseURL        string
+	userAgent      string
+	httpClient     *http.Client
+	maxRetries     int
+	retryBaseDelay time.Duration
+	logger         *slog.Logger
+	strict         bool
+}
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithBaseURL overrides the API base URL (e.g., to point at a mock server)
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithTimeout sets the timeout for a single API request
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.httpClient.Timeout = timeout
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithTransport sets the RoundTripper used to perform requests.
+// A nil transport uses http.DefaultTransport.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Client) {
+		c.httpClient.Transport = transport
+	}
+}
+
+// WithRetry configures retries of transient failures (429, 5xx and timeouts).
+// Delays grow exponentially from baseDelay with jitter; maxRetries of 0 disables retries.
+func WithRetry(maxRetries int, baseDelay time.Duration) Option {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+		c.retryBaseDelay = baseDelay
+	}
+}
+
+// WithLogger sets the logger requests are traced to.
+// A nil logger uses slog.Default().
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// WithStrictDecoding makes decoding fail on fields the models do not know about
+func WithStrictDecoding(strict bool) Option {
+	return func(c *Client) {
+		c.strict = strict
+	}
+}
+
+// NewClient creates a new Blast API client
+func NewClient(opts ...Option) *Client {
+	c := &Client{
+		baseURL:        BaseURL,
+		userAgent:      DefaultUserAgent,
+		httpClient:     &http.Client{Timeout: DefaultTimeout},
+		maxRetries:     DefaultMaxRetries,
+		retryBaseDelay: DefaultRetryBaseDelay,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// BaseURL returns the base URL the client sends requests to
+func (c *Client) BaseURL() string {
+	return c.baseURL
+}
+
+// CircuitTournamentsPath returns the API path listing the tournaments of a circuit
+func CircuitTournamentsPath(circuit string) string {
+	return fmt.Sprintf("/circuits/%s/tournaments?game=rl", url.PathEscape(circuit))
+}
+
+// TournamentMatchesPath returns the API path listing the matches of a tournament
+func TournamentMatchesPath(tournamentID string) string {
+	return fmt.Sprintf("/games/rl/tournaments/%s/matches", url.PathEscape(tournamentID))
+}
+
+// TournamentBracketsPath returns the API path listing the brackets of a tournament
+func TournamentBracketsPath(tournamentID string) string {
+	return fmt.Sprintf("/games/rl/tournaments/%s/brackets", url.PathEscape(tournamentID))
+}
+
+// MatchDetailPath returns the API path of a match's details
+func MatchDetailPath(matchID string) string {
+	return fmt.Sprintf("/matches/%s/detailed", url.PathEscape(matchID))
+}
+
+// Fetch returns the raw response body of an API path, e.g. for schema checks
+func (c *Client) Fetch(ctx context.Context, path string) ([]byte, error) {
+	return c.fetch(ctx, path, nil)
+}
+
+// ListTournaments returns all Rocket League tournaments in a circuit
+func (c *Client) ListTournaments(ctx context.Context, circuit string) ([]Tournament, error) {
+	path := CircuitTournamentsPath(circuit)
+
+	var tournaments []Tournament
+	if err := c.get(ctx, path, nil, &tournaments); err != nil {
+		return nil, err
+	}
+	return tournaments, nil
+}
+
+// TournamentMatches returns all matches of a tournament
+func (c *Client) TournamentMatches(ctx context.Context, tournamentID string) ([]MatchResponse, error) {
+	path := TournamentMatchesPath(tournamentID)
+
+	var matches []MatchResponse
+	if err := c.get(ctx, path, fmt.Errorf("tournament %w: %s", ErrNotFound, tournamentID), &matches); err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
+
+// TournamentBrackets returns all brackets of a tournament
+func (c *Client) TournamentBrackets(ctx context.Context, tournamentID string) ([]Bracket, error) {
+	path := TournamentBracketsPath(tournamentID)
+
+	var brackets []Bracket
+	if err := c.get(ctx, path, fmt.Errorf("tournament %w: %s", ErrNotFound, tournamentID), &brackets); err != nil {
+		return nil, err
+	}
+	return brackets, nil
+}
+
+// MatchDetail returns detailed information for a single match
+func (c *Client) MatchDetail(ctx context.Context, matchID string) (MatchResponse, error) {
+	path := MatchDetailPath(matchID)
+
+	var match MatchResponse
+	if err := c.get(ctx, path, fmt.Errorf("match %w: %s", ErrNotFound, matchID), &match); err != nil {
+		return MatchResponse{}, err
+	}
+	return match, nil
+}
+
+// get performs a GET request against path and decodes the JSON body into v.
+// If notFound is non-nil it is returned as the error for a 404 response.
+func (c *Client) get(ctx context.Context, path string, notFound error, v interface{}) error {
+	body, err := c.fetch(ctx, path, notFound)
+	if err != nil {
+		return err
+	}
+
+	if c.strict {
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(v); err != nil {
+			return fmt.Errorf("failed to parse JSON: %w", err)
+		}
+		return nil
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	return nil
+}
+
+// fetch performs a GET request against path and returns the response body.
+// Transient failures are retried with exponential backoff.
+func (c *Client) fetch(ctx context.Context, path string, notFound error) ([]byte, error) {
+	endpoint := c.baseURL + path
+
+	for attempt := 0; ; attempt++ {
+		body, retryAfter, err := c.do(ctx, endpoint, attempt)
+		if err == nil {
+			return body, nil
+		}
+
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound && notFound != nil {
+			return nil, notFound
+		}
+
+		if attempt >= c.maxRetries || !isRetryable(ctx, err) {
+			return nil, err
+		}
+
+		delay := c.backoff(attempt)
+		if retryAfter > 0 {
+			delay = retryAfter
+		}
+		if delay > maxRetryDelay {
+			return nil, err
+		}
+
+		c.log().DebugContext(ctx, "retrying request",
+			"url", endpoint,
+			"attempt", attempt+1,
+			"delay", delay,
+			"error", err,
+		)
+
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(delay):
+		}
+	}
+}
+
+// do performs a single request and returns the response body on 200 OK.
+// For other status codes it returns a typed error and the Retry-After delay.
+func (c *Client) do(ctx context.Context, endpoint string, attempt int) ([]byte, time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+
+	start := time.Now()
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		c.log().DebugContext(ctx, "api request failed",
+			"method", req.Method,
+			"url", endpoint,
+			"attempt", attempt,
+			"latency", time.Since(start),
+			"error", err,
+		)
+		return nil, 0, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, maxBodySnippet+1))
+		c.logResponse(ctx, req, resp, attempt, start, len(snippet))
+		httpErr := newHTTPError(resp.StatusCode, endpoint, snippet)
+		retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return nil, retryAfter, &RateLimitError{RetryAfter: retryAfter, Err: httpErr}
+		}
+		return nil, retryAfter, httpErr
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read response body: %w", err)
+	}
+	c.logResponse(ctx, req, resp, attempt, start, len(body))
+
+	return body, 0, nil
+}
+
+// logResponse traces a completed request
+func (c *Client) logResponse(ctx context.Context, req *http.Request, resp *http.Response, attempt int, start time.Time, bytes int) {
+	attrs := []any{
+		"method", req.Method,
+		"url", req.URL.String(),
+		"status", resp.StatusCode,
+		"attempt", attempt,
+		"latency", time.Since(start),
+		"bytes", bytes,
+	}
+	if cache := resp.Header.Get(CacheStatusHeader); cache != "" {
+		attrs = append(attrs, "cache", cache)
+	}
+	c.log().DebugContext(ctx, "api request", attrs...)
+}
+
+func (c *Client) log() *slog.Logger {
+	if c.logger == nil {
+		return slog.Default()
+	}
+	return c.logger
+}
+
+// backoff returns the delay before retry number attempt+1 using
+// exponential growth with jitter between half and the full delay
+func (c *Client) backoff(attempt int) time.Duration {
+	delay := c.retryBaseDelay << attempt
+	if delay < 0 || delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	half := delay / 2
+	return half + time.Duration(rand.Int64N(int64(half)+1))
+}
+
+// isRetryable reports whether a failed request may succeed when retried
+func isRetryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return true
+	}
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode >= 500
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -0,0 +1,231 @@
+package blast
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClient_Endpoints(t *testing.T) {
+	var gotUserAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUserAgent = r.Header.Get("User-Agent")
+		switch r.URL.Path {
+		case "/circuits/2026/tournaments":
+			assert.Equal(t, "rl", r.URL.Query().Get("game"))
+			w.Write([]byte(`[{"id":"t1","name":"Tournament One"}]`))
+		case "/games/rl/tournaments/t1/matches":
+			w.Write([]byte(`[{"id":"m1","name":"Match One"}]`))
+		case "/games/rl/tournaments/t1/brackets":
+			w.Write([]byte(`[{"tournamentUuid":"b1","label":"Playoffs"}]`))
+		case "/matches/m1/detailed":
+			w.Write([]byte(`{"id":"m1","name":"Match One"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL+"/"), WithUserAgent("rlcs-cli/test"))
+	ctx := context.Background()
+
+	tournaments, err := client.ListTournaments(ctx, "2026")
+	require.NoError(t, err)
+	require.Len(t, tournaments, 1)
+	assert.Equal(t, "t1", tournaments[0].ID)
+	assert.Equal(t, "rlcs-cli/test", gotUserAgent)
+
+	matches, err := client.TournamentMatches(ctx, "t1")
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	assert.Equal(t, "m1", matches[0].ID)
+
+	brackets, err := client.TournamentBrackets(ctx, "t1")
+	require.NoError(t, err)
+	require.Len(t, brackets, 1)
+	assert.Equal(t, "Playoffs", brackets[0].Label)
+
+	match, err := client.MatchDetail(ctx, "m1")
+	require.NoError(t, err)
+	assert.Equal(t, "Match One", match.Name)
+
+	_, err = client.MatchDetail(ctx, "missing")
+	assert.EqualError(t, err, "match not found: missing")
+
+	_, err = client.ListTournaments(ctx, "1999")
+	assert.EqualError(t, err, "unexpected status code: 404")
+}
+
+func TestClient_WithTransport(t *testing.T) {
+	var gotURL string
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		gotURL = req.URL.String()
+		return httptest.NewRecorder().Result(), nil
+	})
+
+	client := NewClient(WithTransport(transport), WithTimeout(time.Second))
+	_, err := client.ListTournaments(context.Background(), "2026")
+
+	assert.EqualError(t, err, "failed to parse JSON: unexpected end of JSON input")
+	assert.Equal(t, BaseURL+"/circuits/2026/tournaments?game=rl", gotURL)
+	assert.Equal(t, BaseURL, client.BaseURL())
+}
+
+func TestClient_Retry(t *testing.T) {
+	t.Run("retries 5xx until success", func(t *testing.T) {
+		attempts := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			if attempts < 3 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			w.Write([]byte(`[]`))
+		}))
+		defer server.Close()
+
+		client := NewClient(WithBaseURL(server.URL), WithRetry(3, time.Millisecond))
+		_, err := client.ListTournaments(context.Background(), "2026")
+		require.NoError(t, err)
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("returns HTTPError after retries are exhausted", func(t *testing.T) {
+		attempts := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte("upstream unavailable"))
+		}))
+		defer server.Close()
+
+		client := NewClient(WithBaseURL(server.URL), WithRetry(2, time.Millisecond))
+		_, err := client.TournamentMatches(context.Background(), "t1")
+
+		var httpErr *HTTPError
+		require.ErrorAs(t, err, &httpErr)
+		assert.Equal(t, http.StatusServiceUnavailable, httpErr.StatusCode)
+		assert.Equal(t, "upstream unavailable", httpErr.Body)
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("does not retry 4xx", func(t *testing.T) {
+		attempts := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		client := NewClient(WithBaseURL(server.URL), WithRetry(3, time.Millisecond))
+		_, err := client.TournamentBrackets(context.Background(), "t1")
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.EqualError(t, err, "tournament not found: t1")
+		assert.Equal(t, 1, attempts)
+
+		_, err = client.ListTournaments(context.Background(), "2030")
+		assert.ErrorIs(t, err, ErrNotFound)
+		var httpErr *HTTPError
+		require.ErrorAs(t, err, &httpErr)
+		assert.Equal(t, http.StatusNotFound, httpErr.StatusCode)
+	})
+
+	t.Run("rate limit honors Retry-After", func(t *testing.T) {
+		attempts := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			w.Header().Set("Retry-After", "120")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		client := NewClient(WithBaseURL(server.URL), WithRetry(3, time.Millisecond))
+		_, err := client.ListTournaments(context.Background(), "2026")
+
+		var rateLimitErr *RateLimitError
+		require.ErrorAs(t, err, &rateLimitErr)
+		assert.Equal(t, 120*time.Second, rateLimitErr.RetryAfter)
+
+		var httpErr *HTTPError
+		require.ErrorAs(t, err, &httpErr)
+		assert.Equal(t, http.StatusTooManyRequests, httpErr.StatusCode)
+
+		// Retry-After exceeds the maximum delay so the client gives up immediately
+		assert.Equal(t, 1, attempts)
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Duration(0), parseRetryAfter("", now))
+	assert.Equal(t, 5*time.Second, parseRetryAfter("5", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("-1", now))
+	assert.Equal(t, 90*time.Second, parseRetryAfter("Sat, 10 Jan 2026 12:01:30 GMT", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("garbage", now))
+}
+
+func TestClient_Logging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	transport := NewCacheTransport(t.TempDir(), nil)
+	client := NewClient(WithBaseURL(server.URL), WithTransport(transport), WithLogger(logger))
+
+	_, err := client.ListTournaments(context.Background(), "2026")
+	require.NoError(t, err)
+	_, err = client.ListTournaments(context.Background(), "2026")
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+	assert.Equal(t, "api request", entry["msg"])
+	assert.Equal(t, "GET", entry["method"])
+	assert.Equal(t, server.URL+"/circuits/2026/tournaments?game=rl", entry["url"])
+	assert.Equal(t, float64(200), entry["status"])
+	assert.Equal(t, float64(2), entry["bytes"])
+	assert.Equal(t, CacheMiss, entry["cache"])
+	assert.Contains(t, entry, "latency")
+
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &entry))
+	assert.Equal(t, CacheHit, entry["cache"])
+}
+
+func TestClient_StrictDecoding(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id":"t1","newField":true}]`))
+	}))
+	defer server.Close()
+
+	_, err := NewClient(WithBaseURL(server.URL)).ListTournaments(context.Background(), "2026")
+	assert.NoError(t, err)
+
+	_, err = NewClient(WithBaseURL(server.URL), WithStrictDecoding(true)).ListTournaments(context.Background(), "2026")
+	assert.ErrorContains(t, err, `unknown field "newField"`)
+
+	body, err := NewClient(WithBaseURL(server.URL)).Fetch(context.Background(), CircuitTournamentsPath("2026"))
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"id":"t1","newField":true}]`, string(body))
+}
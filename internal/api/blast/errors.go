@@ -0,0 +1,83 @@
+package blast
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrNotFound is returned when the requested resource does not exist
+var ErrNotFound = errors.New("not found")
+
+// maxBodySnippet is the maximum number of body bytes kept in an HTTPError
+const maxBodySnippet = 512
+
+// HTTPError is returned for unexpected HTTP status codes
+type HTTPError struct {
+	StatusCode int
+	URL        string
+	// Body is the beginning of the response body, useful for diagnostics
+	Body string
+}
+
+func (e *HTTPError) Error() string {
+	if e.Body == "" {
+		return fmt.Sprintf("unexpected status code: %d", e.StatusCode)
+	}
+	return fmt.Sprintf("unexpected status code: %d: %s", e.StatusCode, e.Body)
+}
+
+// Is reports a 404 response as ErrNotFound
+func (e *HTTPError) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
+// RateLimitError is returned when the API keeps responding with 429 Too Many Requests
+type RateLimitError struct {
+	// RetryAfter is the delay requested by the API, zero if it sent none
+	RetryAfter time.Duration
+	Err        *HTTPError
+}
+
+func (e *RateLimitError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("rate limited by API (retry after %s)", e.RetryAfter)
+	}
+	return "rate limited by API"
+}
+
+func (e *RateLimitError) Unwrap() error {
+	return e.Err
+}
+
+// newHTTPError creates an HTTPError from a response body
+func newHTTPError(statusCode int, url string, body []byte) *HTTPError {
+	snippet := strings.TrimSpace(string(body))
+	if len(snippet) > maxBodySnippet {
+		snippet = snippet[:maxBodySnippet] + "..."
+	}
+	return &HTTPError{StatusCode: statusCode, URL: url, Body: snippet}
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if d := date.Sub(now); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
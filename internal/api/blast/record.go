@@ -0,0 +1,215 @@
+package blast
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// ManifestFile is the name of the manifest in a recording directory
+const ManifestFile = "manifest.json"
+
+// Manifest lists all request/response pairs in a recording directory
+type Manifest struct {
+	Entries []ManifestEntry `json:"entries"`
+}
+
+// ManifestEntry describes a single recorded request/response pair
+type ManifestEntry struct {
+	Method     string    `json:"method"`
+	URL        string    `json:"url"`
+	StatusCode int       `json:"statusCode"`
+	File       string    `json:"file"`
+	RecordedAt time.Time `json:"recordedAt"`
+}
+
+// recordedResponse is the on-disk representation of a recorded response.
+// JSON bodies are stored as-is so recordings stay readable and editable.
+type recordedResponse struct {
+	StatusCode int             `json:"statusCode"`
+	Header     http.Header     `json:"header,omitempty"`
+	JSON       json.RawMessage `json:"json,omitempty"`
+	Body       string          `json:"body,omitempty"`
+}
+
+// RecordTransport is an http.RoundTripper that writes every request/response
+// pair to a directory along with a manifest
+type RecordTransport struct {
+	// Dir is the directory responses and the manifest are written to
+	Dir string
+	// Next performs the actual requests; nil uses http.DefaultTransport
+	Next http.RoundTripper
+
+	mu       sync.Mutex
+	manifest Manifest
+}
+
+// NewRecordTransport creates a transport recording all traffic to dir
+func NewRecordTransport(dir string, next http.RoundTripper) (*RecordTransport, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create record dir: %w", err)
+	}
+	return &RecordTransport{Dir: dir, Next: next}, nil
+}
+
+func (t *RecordTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := t.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err := t.record(req, resp, body); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (t *RecordTransport) record(req *http.Request, resp *http.Response, body []byte) error {
+	recorded := recordedResponse{
+		StatusCode: resp.StatusCode,
+		Header:     http.Header{"Content-Type": resp.Header.Values("Content-Type")},
+	}
+	if json.Valid(body) {
+		recorded.JSON = body
+	} else {
+		recorded.Body = string(body)
+	}
+
+	data, err := json.MarshalIndent(recorded, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode recorded response: %w", err)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	file := fmt.Sprintf("%04d.json", len(t.manifest.Entries)+1)
+	if err := os.WriteFile(filepath.Join(t.Dir, file), data, 0o644); err != nil {
+		return fmt.Errorf("failed to write recorded response: %w", err)
+	}
+
+	t.manifest.Entries = append(t.manifest.Entries, ManifestEntry{
+		Method:     req.Method,
+		URL:        req.URL.RequestURI(),
+		StatusCode: resp.StatusCode,
+		File:       file,
+		RecordedAt: time.Now().UTC(),
+	})
+
+	manifest, err := json.MarshalIndent(t.manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(t.Dir, ManifestFile), manifest, 0o644); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+
+	return nil
+}
+
+// ReplayTransport is an http.RoundTripper that serves responses from a
+// recording directory instead of the network. Requests without a recorded
+// response fail. If a request was recorded several times the responses are
+// served in order, repeating the last one.
+type ReplayTransport struct {
+	dir string
+
+	mu        sync.Mutex
+	responses map[string][]ManifestEntry
+	served    map[string]int
+}
+
+// NewReplayTransport loads the manifest of a recording directory
+func NewReplayTransport(dir string) (*ReplayTransport, error) {
+	data, err := os.ReadFile(filepath.Join(dir, ManifestFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read replay manifest: %w", err)
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse replay manifest: %w", err)
+	}
+
+	t := &ReplayTransport{
+		dir:       dir,
+		responses: make(map[string][]ManifestEntry),
+		served:    make(map[string]int),
+	}
+	for _, entry := range manifest.Entries {
+		key := replayKey(entry.Method, entry.URL)
+		t.responses[key] = append(t.responses[key], entry)
+	}
+	return t, nil
+}
+
+func (t *ReplayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := replayKey(req.Method, req.URL.RequestURI())
+
+	t.mu.Lock()
+	entries := t.responses[key]
+	index := t.served[key]
+	if index < len(entries)-1 {
+		t.served[key]++
+	}
+	t.mu.Unlock()
+
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no recorded response for %s %s", req.Method, req.URL.RequestURI())
+	}
+
+	data, err := os.ReadFile(filepath.Join(t.dir, entries[index].File))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read recorded response: %w", err)
+	}
+
+	var recorded recordedResponse
+	if err := json.Unmarshal(data, &recorded); err != nil {
+		return nil, fmt.Errorf("failed to parse recorded response %s: %w", entries[index].File, err)
+	}
+
+	body := []byte(recorded.Body)
+	if len(recorded.JSON) > 0 {
+		body = recorded.JSON
+	}
+
+	header := recorded.Header
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func replayKey(method, requestURI string) string {
+	return method + " " + requestURI
+}
@@ -0,0 +1,65 @@
+package blast
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/circuits/2026/tournaments":
+			w.Write([]byte(`[{"id":"t1","name":"Tournament One"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("not here"))
+		}
+	}))
+	dir := t.TempDir()
+
+	recorder, err := NewRecordTransport(dir, nil)
+	require.NoError(t, err)
+	client := NewClient(WithBaseURL(server.URL+"/v2"), WithTransport(recorder))
+
+	_, err = client.ListTournaments(context.Background(), "2026")
+	require.NoError(t, err)
+	_, err = client.MatchDetail(context.Background(), "missing")
+	require.ErrorIs(t, err, ErrNotFound)
+	server.Close()
+
+	data, err := os.ReadFile(filepath.Join(dir, ManifestFile))
+	require.NoError(t, err)
+	var manifest Manifest
+	require.NoError(t, json.Unmarshal(data, &manifest))
+	require.Len(t, manifest.Entries, 2)
+	assert.Equal(t, "/v2/circuits/2026/tournaments?game=rl", manifest.Entries[0].URL)
+	assert.Equal(t, http.StatusNotFound, manifest.Entries[1].StatusCode)
+
+	replayer, err := NewReplayTransport(dir)
+	require.NoError(t, err)
+	client = NewClient(WithTransport(replayer))
+
+	tournaments, err := client.ListTournaments(context.Background(), "2026")
+	require.NoError(t, err)
+	require.Len(t, tournaments, 1)
+	assert.Equal(t, "Tournament One", tournaments[0].Name)
+
+	_, err = client.MatchDetail(context.Background(), "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = client.ListTournaments(context.Background(), "2025")
+	assert.ErrorContains(t, err, "no recorded response for GET /v2/circuits/2025/tournaments?game=rl")
+}
+
+func TestReplayTransport_MissingManifest(t *testing.T) {
+	_, err := NewReplayTransport(t.TempDir())
+	assert.ErrorContains(t, err, "failed to read replay manifest")
+}
@@ -0,0 +1,123 @@
+// Package calendar turns series and tournaments into calendar events
+package calendar
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+)
+
+// uidDomain is appended to event UIDs to make them globally unique
+const uidDomain = "rlcs-cli"
+
+const (
+	// gameAllowance is the time blocked per game of a series, games take about seven minutes plus breaks
+	gameAllowance = 12 * time.Minute
+	// defaultDuration is the duration of series whose length is unknown
+	defaultDuration = time.Hour
+)
+
+// Event is a calendar event
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	Location    string
+	Start       time.Time
+	// End is exclusive, for all-day events it is the day after the last day
+	End    time.Time
+	AllDay bool
+	// Sequence is the revision of the event, it increases every time the event changes
+	Sequence     int
+	LastModified time.Time
+}
+
+// hash identifies the content of an event, a change of the hash is a new revision
+func (e Event) hash() string {
+	sum := sha256.New()
+	fmt.Fprintf(sum, "%s\n%s\n%s\n%s\n%s\n%t", e.Summary, e.Description, e.Location,
+		e.Start.UTC().Format(time.RFC3339), e.End.UTC().Format(time.RFC3339), e.AllDay)
+	return hex.EncodeToString(sum.Sum(nil))
+}
+
+// SeriesEvent returns the event of a series of a tournament.
+// Its UID is derived from the UUID of the series, so that the event keeps its identity when it is rescheduled.
+func SeriesEvent(tournament domain.Tournament, match domain.Match) Event {
+	summary := fmt.Sprintf("%s vs %s", teamName(match.TeamA), teamName(match.TeamB))
+	if match.TeamA.UUID == "" && match.TeamB.UUID == "" && match.Name != "" {
+		summary = match.Name
+	}
+
+	description := []string{tournament.Name}
+	stage := match.Name
+	if match.Type != "" {
+		stage = strings.TrimSpace(fmt.Sprintf("%s (%s)", stage, match.Type))
+	}
+	if stage != "" {
+		description = append(description, stage)
+	}
+
+	return Event{
+		UID:         match.UUID + "@" + uidDomain,
+		Summary:     summary,
+		Description: strings.Join(description, "\n"),
+		Location:    tournament.Location,
+		Start:       match.TimeOfSeries.UTC(),
+		End:         match.TimeOfSeries.UTC().Add(SeriesDuration(match)),
+	}
+}
+
+// TournamentEvent returns an all-day event spanning the days of a tournament
+func TournamentEvent(tournament domain.Tournament) Event {
+	start := day(tournament.StartDate)
+	end := day(tournament.EndDate)
+	if end.Before(start) {
+		end = start
+	}
+
+	var description []string
+	if tournament.TeamCount > 0 {
+		description = append(description, fmt.Sprintf("%d teams", tournament.TeamCount))
+	}
+	if tournament.PrizePool != "" {
+		description = append(description, "Prize pool: "+tournament.PrizePool)
+	}
+
+	return Event{
+		UID:         "tournament-" + tournament.ID + "@" + uidDomain,
+		Summary:     tournament.Name,
+		Description: strings.Join(description, "\n"),
+		Location:    tournament.Location,
+		Start:       start,
+		End:         end.AddDate(0, 0, 1),
+		AllDay:      true,
+	}
+}
+
+// SeriesDuration estimates the length of a series from its type, e.g. 60 minutes for a best of 5
+// and 90 minutes for a best of 7. Series of unknown length last an hour.
+func SeriesDuration(match domain.Match) time.Duration {
+	bestOf := match.BestOf()
+	if bestOf == 0 {
+		return defaultDuration
+	}
+	quarter := 15 * time.Minute
+	return (time.Duration(bestOf)*gameAllowance + quarter - 1) / quarter * quarter
+}
+
+func teamName(team domain.MatchTeam) string {
+	if team.Name == "" {
+		return "TBD"
+	}
+	return team.Name
+}
+
+// day returns the calendar day of t in UTC
+func day(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,89 @@
+package calendar
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeriesDuration(t *testing.T) {
+	assert.Equal(t, 60*time.Minute, SeriesDuration(domain.Match{Type: "BO5"}))
+	assert.Equal(t, 90*time.Minute, SeriesDuration(domain.Match{Type: "BO7"}))
+	assert.Equal(t, 45*time.Minute, SeriesDuration(domain.Match{Type: "BO3"}))
+	assert.Equal(t, time.Hour, SeriesDuration(domain.Match{}))
+}
+
+func TestSeriesEvent(t *testing.T) {
+	tournament := domain.Tournament{ID: "major", Name: "RLCS 2026 Major 1", Location: "Paris"}
+	match := domain.Match{
+		UUID:         "p-final",
+		Name:         "Grand Final",
+		Type:         "BO7",
+		TimeOfSeries: time.Date(2026, 3, 5, 18, 0, 0, 0, time.UTC),
+		TeamA:        domain.MatchTeam{UUID: "vit", Name: "Team Vitality"},
+	}
+
+	event := SeriesEvent(tournament, match)
+	assert.Equal(t, "p-final@rlcs-cli", event.UID)
+	assert.Equal(t, "Team Vitality vs TBD", event.Summary)
+	assert.Equal(t, "RLCS 2026 Major 1\nGrand Final (BO7)", event.Description)
+	assert.Equal(t, "Paris", event.Location)
+	assert.Equal(t, time.Date(2026, 3, 5, 19, 30, 0, 0, time.UTC), event.End)
+	assert.False(t, event.AllDay)
+
+	event = SeriesEvent(tournament, domain.Match{UUID: "x", Name: "Lower Final"})
+	assert.Equal(t, "Lower Final", event.Summary)
+}
+
+func TestTournamentEvent(t *testing.T) {
+	event := TournamentEvent(domain.Tournament{
+		ID:        "major",
+		Name:      "RLCS 2026 Major 1",
+		StartDate: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC),
+		TeamCount: 16,
+	})
+
+	assert.Equal(t, "tournament-major@rlcs-cli", event.UID)
+	assert.True(t, event.AllDay)
+	assert.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), event.Start)
+	assert.Equal(t, time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC), event.End)
+	assert.Equal(t, "16 teams", event.Description)
+}
+
+func TestState_Apply(t *testing.T) {
+	first := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	second := first.Add(24 * time.Hour)
+	start := time.Date(2026, 3, 5, 18, 0, 0, 0, time.UTC)
+	event := func(start time.Time) Event {
+		return Event{UID: "p-final@rlcs-cli", Summary: "Team Vitality vs TBD", Start: start, End: start.Add(90 * time.Minute)}
+	}
+
+	path := filepath.Join(t.TempDir(), "state", "schedule.json")
+	state, err := LoadState(path)
+	require.NoError(t, err)
+
+	events := []Event{event(start)}
+	state.Apply(events, first)
+	assert.Equal(t, 0, events[0].Sequence)
+	assert.Equal(t, first, events[0].LastModified)
+	require.NoError(t, state.Save(path))
+
+	// An unchanged event keeps its revision
+	state, err = LoadState(path)
+	require.NoError(t, err)
+	events = []Event{event(start)}
+	state.Apply(events, second)
+	assert.Equal(t, 0, events[0].Sequence)
+	assert.Equal(t, first, events[0].LastModified)
+
+	// A rescheduled event moves to the next sequence
+	events = []Event{event(start.Add(time.Hour))}
+	state.Apply(events, second)
+	assert.Equal(t, 1, events[0].Sequence)
+	assert.Equal(t, second, events[0].LastModified)
+}
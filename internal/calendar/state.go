@@ -0,0 +1,81 @@
+package calendar
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// State remembers the revision of every exported event, so that events that changed
+// since the last export get a higher SEQUENCE and a new LAST-MODIFIED
+type State struct {
+	Events map[string]Revision `json:"events"`
+}
+
+// Revision is the last exported revision of an event
+type Revision struct {
+	Hash         string    `json:"hash"`
+	Sequence     int       `json:"sequence"`
+	LastModified time.Time `json:"last_modified"`
+}
+
+// LoadState reads the state file at path.
+// A missing file is not an error and results in an empty state.
+func LoadState(path string) (*State, error) {
+	state := &State{Events: make(map[string]Revision)}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read calendar state: %w", err)
+	}
+
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to parse calendar state %s: %w", path, err)
+	}
+	if state.Events == nil {
+		state.Events = make(map[string]Revision)
+	}
+	return state, nil
+}
+
+// Save writes the state file to path, creating its directory if needed
+func (s *State) Save(path string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode calendar state: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create calendar state dir: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write calendar state: %w", err)
+	}
+	return nil
+}
+
+// Apply sets the sequence and last modification time of the events. New events start at
+// sequence 0, events whose content changed since the last export move to the next sequence,
+// both are modified at now. Unchanged events keep their revision.
+func (s *State) Apply(events []Event, now time.Time) {
+	now = now.UTC().Truncate(time.Second)
+	for i := range events {
+		hash := events[i].hash()
+		revision, ok := s.Events[events[i].UID]
+		switch {
+		case !ok:
+			revision = Revision{Hash: hash, LastModified: now}
+		case revision.Hash != hash:
+			revision = Revision{Hash: hash, Sequence: revision.Sequence + 1, LastModified: now}
+		}
+		s.Events[events[i].UID] = revision
+		events[i].Sequence = revision.Sequence
+		events[i].LastModified = revision.LastModified
+	}
+}
@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/config"
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/teams"
+)
+
+// AgendaFlags select the series shown by the today and week commands
+type AgendaFlags struct {
+	Circuit string        `help:"Circuit/year to fetch tournaments from (e.g., 2025, 2026)" default:""`
+	Team    []string      `help:"Follow these teams instead of the configured ones (UUID, name or shorthand, repeatable)"`
+	Region  []string      `help:"Follow the regional tournaments of these regions instead of the configured ones (repeatable)"`
+	All     bool          `help:"Show the series of every team instead of the followed ones"`
+	Output  output.Format `help:"Output format (table, json, csv, yaml)" default:"table" short:"o"`
+
+	FetchFlags `embed:""`
+
+	// now is a function that returns the current time, can be overridden for testing
+	now func() time.Time `kong:"-"`
+	// out receives the output, defaults to os.Stdout
+	out io.Writer `kong:"-"`
+}
+
+// TodayCmd shows the series of followed teams today
+type TodayCmd struct {
+	AgendaFlags `embed:""`
+}
+
+func (t *TodayCmd) Run(ctx *Context) error {
+	return t.run(ctx, 1)
+}
+
+// WeekCmd shows the series of followed teams in the next seven days
+type WeekCmd struct {
+	AgendaFlags `embed:""`
+}
+
+func (w *WeekCmd) Run(ctx *Context) error {
+	return w.run(ctx, 7)
+}
+
+// run shows the live series, the series starting in the given number of days from the start of today
+// in the time zone of the CLI and the series that finished today across all tournaments of the circuit
+func (a *AgendaFlags) run(ctx *Context, days int) error {
+	if a.now == nil {
+		a.now = time.Now
+	}
+	if a.out == nil {
+		a.out = os.Stdout
+	}
+
+	follow := a.following(ctx.settings().Follow)
+	if !a.All && follow.empty() {
+		return fmt.Errorf("no followed teams or regions, add them to the follow section of the config file or pass --team, --region or --all")
+	}
+
+	formatter, err := output.GetAgendaFormatter(a.Output, a.now)
+	if err != nil {
+		return fmt.Errorf("failed to get formatter: %w", err)
+	}
+
+	now := a.now().In(ctx.location())
+	circuit := a.Circuit
+	if circuit == "" {
+		circuit = fmt.Sprintf("%d", now.Year())
+	}
+	year, month, day := now.Date()
+	from := time.Date(year, month, day, 0, 0, 0, 0, now.Location())
+	to := from.AddDate(0, 0, days)
+
+	// Tournaments are fetched if they overlap the window, a day of slack keeps delayed series of a tournament that should have ended
+	tournaments, err := a.fetchCircuit(ctx, circuit, func(t domain.Tournament) bool {
+		if !t.StartDate.IsZero() && !t.StartDate.Before(to) {
+			return false
+		}
+		return t.EndDate.IsZero() || !t.EndDate.Before(from.AddDate(0, 0, -1))
+	})
+	if err != nil {
+		return err
+	}
+
+	var keep func(domain.Tournament, domain.Match) bool
+	if !a.All {
+		keep = follow.matches
+	}
+
+	if err := formatter.Format(a.out, agenda(tournaments, from, to, now, keep)); err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+
+	return nil
+}
+
+// following returns the teams and regions given on the command line, or the configured ones if there are none
+func (a *AgendaFlags) following(cfg config.Follow) following {
+	if len(a.Team) > 0 || len(a.Region) > 0 {
+		return following{teams: a.Team, regions: a.Region}
+	}
+	return following{teams: cfg.Teams, regions: cfg.Regions}
+}
+
+// following holds the followed teams and regions
+type following struct {
+	teams   []string
+	regions []string
+}
+
+func (f following) empty() bool {
+	return len(f.teams) == 0 && len(f.regions) == 0
+}
+
+// matches returns true if a followed team plays the match or it is part of a regional tournament of a followed region
+func (f following) matches(t domain.Tournament, match domain.Match) bool {
+	for _, region := range f.regions {
+		if t.Region != "" && strings.EqualFold(string(t.Region), region) {
+			return true
+		}
+	}
+	for _, query := range f.teams {
+		if teams.IsParticipant(match.TeamA, query) || teams.IsParticipant(match.TeamB, query) {
+			return true
+		}
+	}
+	return false
+}
+
+// agenda returns the live series, the series scheduled in [from, to) that have not started yet
+// and the series that started since from and are over, sorted like tournaments matches.
+// Only the series accepted by keep are returned, all series if it is nil.
+func agenda(tournaments []domain.TournamentMatches, from, to, now time.Time, keep func(domain.Tournament, domain.Match) bool) []domain.GameListing {
+	games := make([]domain.GameListing, 0)
+	for _, t := range tournaments {
+		for _, match := range t.Matches {
+			start := match.TimeOfSeries
+			switch {
+			case match.IsInProgress():
+			case match.IsOver():
+				if start.IsZero() || start.Before(from) || start.After(now) {
+					continue
+				}
+			default:
+				if start.IsZero() || start.Before(from) || !start.Before(to) {
+					continue
+				}
+			}
+			if keep != nil && !keep(t.Tournament, match) {
+				continue
+			}
+			games = append(games, domain.GameListing{
+				TournamentID:   t.Tournament.ID,
+				TournamentName: t.Tournament.Name,
+				Match:          match,
+			})
+		}
+	}
+
+	sortGames(games)
+	return games
+}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/config"
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// agendaSeries runs the command and returns the UUIDs of the listed series
+func agendaSeries(t *testing.T, ctx *Context, run func(*Context) error, flags *AgendaFlags) []string {
+	t.Helper()
+	var buf bytes.Buffer
+	flags.Output = output.FormatCSV
+	flags.out = &buf
+	require.NoError(t, run(ctx))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	var uuids []string
+	for _, record := range records[1:] {
+		uuids = append(uuids, record[2])
+	}
+	return uuids
+}
+
+func TestAgenda_Snapshot(t *testing.T) {
+	src, err := source.NewSnapshotSource("testdata/snapshot")
+	require.NoError(t, err)
+
+	ctx := &Context{Source: src, Location: time.UTC, Config: &config.Config{Follow: config.Follow{Teams: []string{"VIT"}}}}
+	now := func() time.Time { return time.Date(2026, 3, 4, 18, 0, 0, 0, time.UTC) }
+
+	// The semifinal of today is over, the grand final is tomorrow
+	today := &TodayCmd{AgendaFlags{now: now}}
+	assert.Equal(t, []string{"p-sf1"}, agendaSeries(t, ctx, today.Run, &today.AgendaFlags))
+
+	week := &WeekCmd{AgendaFlags{now: now}}
+	assert.Equal(t, []string{"p-final", "p-sf1"}, agendaSeries(t, ctx, week.Run, &week.AgendaFlags))
+
+	// Teams on the command line replace the configured ones
+	today = &TodayCmd{AgendaFlags{Team: []string{"SSG"}, now: now}}
+	assert.Equal(t, []string{"p-sf2"}, agendaSeries(t, ctx, today.Run, &today.AgendaFlags))
+
+	week = &WeekCmd{AgendaFlags{All: true, now: now}}
+	assert.Equal(t, []string{"p-sf2", "p-final", "p-sf1"}, agendaSeries(t, ctx, week.Run, &week.AgendaFlags))
+
+	// Today starts at midnight in the time zone of the CLI: in Tokyo the semifinal was yesterday
+	// and the grand final is tomorrow
+	tokyo := &Context{Source: src, Location: time.FixedZone("JST", 9*60*60)}
+	today = &TodayCmd{AgendaFlags{All: true, now: now}}
+	assert.Equal(t, []string{"p-sf2"}, agendaSeries(t, tokyo, today.Run, &today.AgendaFlags))
+
+	// Relative times in the table are computed from the time of the command
+	var buf bytes.Buffer
+	week = &WeekCmd{AgendaFlags{Output: output.FormatTable, now: now, out: &buf}}
+	require.NoError(t, week.Run(ctx))
+	assert.Contains(t, buf.String(), "in 1d")
+
+	// Majors belong to no region
+	today = &TodayCmd{AgendaFlags{Region: []string{"EU"}, now: now}}
+	assert.Empty(t, agendaSeries(t, ctx, today.Run, &today.AgendaFlags))
+
+	today = &TodayCmd{AgendaFlags{Output: output.FormatTable, now: now}}
+	assert.EqualError(t, today.Run(&Context{Source: src}), "no followed teams or regions, add them to the follow section of the config file or pass --team, --region or --all")
+
+	today = &TodayCmd{AgendaFlags{Output: "html", now: now}}
+	assert.EqualError(t, today.Run(ctx), "failed to get formatter: no formatter registered for format: html")
+}
+
+func TestAgenda_BetweenGames(t *testing.T) {
+	now := time.Date(2026, 3, 4, 0, 30, 0, 0, time.UTC)
+	from := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+	tournaments := []domain.TournamentMatches{{
+		Tournament: domain.Tournament{ID: "major"},
+		Matches: []domain.Match{
+			// Started yesterday and paused between two games, it is still being played
+			{UUID: "paused", Type: "BO7", TeamAScore: 2, TeamBScore: 1, IsCompleted: true, TimeOfSeries: now.Add(-2 * time.Hour)},
+			// Decided yesterday
+			{UUID: "over", Type: "BO7", TeamAScore: 4, TeamBScore: 1, IsCompleted: true, TimeOfSeries: now.Add(-3 * time.Hour)},
+		},
+	}}
+
+	games := agenda(tournaments, from, from.AddDate(0, 0, 1), now, nil)
+	require.Len(t, games, 1)
+	assert.Equal(t, "paused", games[0].Match.UUID)
+}
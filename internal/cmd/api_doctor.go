@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/api/blast"
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/mapper"
+	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/schema"
+)
+
+// APIDoctorCmd checks sample responses of every Blast endpoint against the API models
+type APIDoctorCmd struct {
+	Circuit    string              `help:"Circuit/year to sample tournaments from (e.g., 2025, 2026)" default:""`
+	Tournament string              `help:"Tournament ID to sample matches and brackets from (defaults to the most recently started tournaments)"`
+	Match      string              `help:"Match ID to sample details from (defaults to a match of the sampled tournaments)"`
+	Samples    int                 `help:"Number of tournaments to sample" default:"1"`
+	Output     output.DoctorFormat `help:"Output format (table, json, yaml)" default:"table" short:"o"`
+
+	// now is a function that returns the current time, can be overridden for testing
+	now func() time.Time `kong:"-"`
+}
+
+func (d *APIDoctorCmd) Run(ctx *Context) error {
+	if d.Samples < 0 {
+		return fmt.Errorf("samples cannot be negative")
+	}
+	if d.Samples == 0 {
+		d.Samples = 1
+	}
+	if d.now == nil {
+		d.now = time.Now
+	}
+
+	circuit := d.Circuit
+	if circuit == "" {
+		circuit = fmt.Sprintf("%d", d.now().Year())
+	}
+
+	issues := d.diagnose(ctx.requestContext(), ctx.blastClient(), circuit)
+
+	formatter, err := output.GetDoctorFormatter(d.Output)
+	if err != nil {
+		return fmt.Errorf("failed to get formatter: %w", err)
+	}
+
+	if err := formatter.Format(os.Stdout, issues); err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+
+	if len(issues) > 0 {
+		return fmt.Errorf("found %d schema issues", len(issues))
+	}
+	return nil
+}
+
+// diagnose fetches sample responses of every endpoint and collects all schema issues
+func (d *APIDoctorCmd) diagnose(reqCtx context.Context, client *blast.Client, circuit string) []schema.Issue {
+	var collector schema.Collector
+
+	var apiTournaments []blast.Tournament
+	path := blast.CircuitTournamentsPath(circuit)
+	d.check(reqCtx, client, &collector, path, &apiTournaments)
+
+	tournaments := make([]domain.Tournament, 0, len(apiTournaments))
+	for _, api := range apiTournaments {
+		tournament, err := mapper.ToDomainTournament(api)
+		if err != nil {
+			collector.Add(invalidValue(path, api.ID, err))
+			continue
+		}
+		tournaments = append(tournaments, tournament)
+	}
+
+	matchID := d.Match
+	for _, tournamentID := range d.sampleTournaments(tournaments) {
+		var apiMatches []blast.MatchResponse
+		path := blast.TournamentMatchesPath(tournamentID)
+		d.check(reqCtx, client, &collector, path, &apiMatches)
+		for _, api := range apiMatches {
+			if _, err := mapper.ToDomainMatchFromDetailResponse(api); err != nil {
+				collector.Add(invalidValue(path, api.ID, err))
+			}
+			if matchID == "" {
+				matchID = api.ID
+			}
+		}
+
+		var apiBrackets []blast.Bracket
+		path = blast.TournamentBracketsPath(tournamentID)
+		d.check(reqCtx, client, &collector, path, &apiBrackets)
+		for _, api := range apiBrackets {
+			if _, err := mapper.ToDomainBracket(api); err != nil {
+				collector.Add(invalidValue(path, api.TournamentUUID, err))
+			}
+		}
+	}
+
+	if matchID != "" {
+		var apiMatch blast.MatchResponse
+		path := blast.MatchDetailPath(matchID)
+		if d.check(reqCtx, client, &collector, path, &apiMatch) {
+			if _, err := mapper.ToDomainMatchFromDetailResponse(apiMatch); err != nil {
+				collector.Add(invalidValue(path, apiMatch.ID, err))
+			}
+		}
+	}
+
+	return collector.Issues()
+}
+
+// check fetches path, reports schema issues against the type of v and decodes into v.
+// It returns false if the response could not be fetched or decoded.
+func (d *APIDoctorCmd) check(reqCtx context.Context, client *blast.Client, collector *schema.Collector, path string, v interface{}) bool {
+	body, err := client.Fetch(reqCtx, path)
+	if err != nil {
+		collector.Add(schema.Issue{Endpoint: path, Path: "$", Kind: schema.RequestFailed, Detail: err.Error()})
+		return false
+	}
+
+	issues, err := schema.Check(path, body, v)
+	if err != nil {
+		collector.Add(schema.Issue{Endpoint: path, Path: "$", Kind: schema.RequestFailed, Detail: err.Error()})
+		return false
+	}
+	for _, issue := range issues {
+		collector.Add(issue)
+	}
+
+	// Type mismatches have been reported already, decode whatever fits
+	json.Unmarshal(body, v)
+	return true
+}
+
+// sampleTournaments picks the tournaments whose matches and brackets are checked.
+// Without an explicit tournament the most recently started ones are used since
+// they are most likely to contain completed and live matches.
+func (d *APIDoctorCmd) sampleTournaments(tournaments []domain.Tournament) []string {
+	if d.Tournament != "" {
+		return []string{d.Tournament}
+	}
+
+	now := d.now()
+	started := make([]domain.Tournament, 0, len(tournaments))
+	for _, t := range tournaments {
+		if !t.IsUpcoming(now) {
+			started = append(started, t)
+		}
+	}
+	if len(started) == 0 {
+		started = tournaments
+	}
+
+	sort.SliceStable(started, func(i, j int) bool {
+		return started[i].StartDate.After(started[j].StartDate)
+	})
+
+	ids := make([]string, 0, d.Samples)
+	for i := 0; i < len(started) && i < d.Samples; i++ {
+		ids = append(ids, started[i].ID)
+	}
+	return ids
+}
+
+func invalidValue(endpoint, recordID string, err error) schema.Issue {
+	return schema.Issue{
+		Endpoint: endpoint,
+		Path:     "$[" + recordID + "]",
+		Kind:     schema.InvalidValue,
+		Detail:   err.Error(),
+	}
+}
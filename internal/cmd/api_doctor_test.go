@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/api/blast"
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIDoctorCmd_diagnose(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/circuits/2026/tournaments":
+			w.Write([]byte(`[
+				{"id":"t1","name":"Open 1","startDate":"2026-01-10","endDate":"2026-01-12","sponsor":"Acme"},
+				{"id":"t2","name":"Open 2","startDate":"10/02/2026","endDate":"2026-02-12"}
+			]`))
+		case "/games/rl/tournaments/t1/matches":
+			w.Write([]byte(`[{"id":"m1","type":5}]`))
+		case "/matches/m1/detailed":
+			w.Write([]byte(`{"id":"m1"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cmd := &APIDoctorCmd{
+		Samples: 1,
+		now:     func() time.Time { return time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC) },
+	}
+	client := blast.NewClient(blast.WithBaseURL(server.URL), blast.WithRetry(0, 0))
+
+	issues := cmd.diagnose(context.Background(), client, "2026")
+
+	find := func(endpoint, path string, kind schema.IssueKind) *schema.Issue {
+		for i := range issues {
+			if issues[i].Endpoint == endpoint && issues[i].Path == path && issues[i].Kind == kind {
+				return &issues[i]
+			}
+		}
+		return nil
+	}
+
+	tournamentsPath := blast.CircuitTournamentsPath("2026")
+	if assert.NotNil(t, find(tournamentsPath, "$[].sponsor", schema.UnknownField)) {
+		assert.Equal(t, 1, find(tournamentsPath, "$[].sponsor", schema.UnknownField).Count)
+	}
+	if invalid := find(tournamentsPath, "$[t2]", schema.InvalidValue); assert.NotNil(t, invalid) {
+		assert.Contains(t, invalid.Detail, "failed to parse start date")
+	}
+
+	matchesPath := blast.TournamentMatchesPath("t1")
+	if mismatch := find(matchesPath, "$[].type", schema.TypeMismatch); assert.NotNil(t, mismatch) {
+		assert.Equal(t, "expected string, got number", mismatch.Detail)
+	}
+
+	if failed := find(blast.TournamentBracketsPath("t1"), "$", schema.RequestFailed); assert.NotNil(t, failed) {
+		assert.Equal(t, "unexpected status code: 404", failed.Detail)
+	}
+
+	assert.NotNil(t, find(blast.MatchDetailPath("m1"), "$.name", schema.MissingField))
+}
+
+func TestAPIDoctorCmd_sampleTournaments(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	tournaments := []domain.Tournament{
+		{ID: "old", StartDate: now.AddDate(0, -2, 0), EndDate: now.AddDate(0, -2, 2)},
+		{ID: "recent", StartDate: now.AddDate(0, 0, -7), EndDate: now.AddDate(0, 0, -5)},
+		{ID: "upcoming", StartDate: now.AddDate(0, 1, 0), EndDate: now.AddDate(0, 1, 2)},
+	}
+
+	cmd := &APIDoctorCmd{Samples: 2, now: func() time.Time { return now }}
+	assert.Equal(t, []string{"recent", "old"}, cmd.sampleTournaments(tournaments))
+
+	cmd = &APIDoctorCmd{Samples: 1, now: func() time.Time { return now }}
+	assert.Equal(t, []string{"upcoming"}, cmd.sampleTournaments(tournaments[2:]))
+
+	cmd = &APIDoctorCmd{Tournament: "explicit"}
+	assert.Equal(t, []string{"explicit"}, cmd.sampleTournaments(tournaments))
+}
@@ -0,0 +1,294 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/mapper"
+	"github.com/mgranderath/rlcs-cli/internal/source"
+)
+
+// defaultConcurrency is the number of tournaments fetched in parallel by default
+const defaultConcurrency = 8
+
+// FetchFlags control how the matches of all tournaments in a circuit are fetched.
+// Commands working across tournaments embed them.
+type FetchFlags struct {
+	Concurrency int  `help:"Maximum number of tournaments fetched in parallel" default:"8"`
+	KeepGoing   bool `help:"Render tournaments that were fetched successfully and report failures on stderr"`
+
+	// stderr receives the failure summary of --keep-going, defaults to os.Stderr
+	stderr io.Writer `kong:"-"`
+	// strict fails a tournament if any of its matches cannot be mapped
+	strict bool `kong:"-"`
+}
+
+// validate checks the flags and applies defaults
+func (f *FetchFlags) validate() error {
+	if f.Concurrency < 0 {
+		return fmt.Errorf("concurrency cannot be negative")
+	}
+	if f.Concurrency == 0 {
+		f.Concurrency = defaultConcurrency
+	}
+	return nil
+}
+
+// tournamentResult holds the matches or brackets fetched for a single tournament
+type tournamentResult struct {
+	tournament domain.Tournament
+	matches    []domain.Match
+	brackets   []domain.Bracket
+	// skipped lists the records that could not be mapped
+	skipped []*mapper.RecordError
+	err     error
+}
+
+// fetchCircuit fetches all tournaments of a circuit accepted by filter along with their matches.
+// With --keep-going tournaments that failed are reported on stderr and left out of the result.
+func (f *FetchFlags) fetchCircuit(ctx *Context, circuit string, filter func(domain.Tournament) bool) ([]domain.TournamentMatches, error) {
+	results, err := f.fetchTournaments(ctx, circuit, filter, "matches", f.fetchAllMatches)
+	if err != nil {
+		return nil, err
+	}
+
+	fetched := make([]domain.TournamentMatches, 0, len(results))
+	for _, result := range results {
+		fetched = append(fetched, domain.TournamentMatches{Tournament: result.tournament, Matches: result.matches})
+	}
+	return fetched, nil
+}
+
+// fetchCircuitBrackets fetches all tournaments of a circuit accepted by filter along with their brackets,
+// see fetchCircuit
+func (f *FetchFlags) fetchCircuitBrackets(ctx *Context, circuit string, filter func(domain.Tournament) bool) ([]domain.TournamentBrackets, error) {
+	results, err := f.fetchTournaments(ctx, circuit, filter, "brackets", f.fetchAllBrackets)
+	if err != nil {
+		return nil, err
+	}
+
+	fetched := make([]domain.TournamentBrackets, 0, len(results))
+	for _, result := range results {
+		fetched = append(fetched, domain.TournamentBrackets{Tournament: result.tournament, Brackets: result.brackets})
+	}
+	return fetched, nil
+}
+
+// fetchTournaments fetches all tournaments of a circuit accepted by filter and fetches the data of each
+// with fetchAll. Only tournaments that were fetched successfully are returned.
+func (f *FetchFlags) fetchTournaments(
+	ctx *Context,
+	circuit string,
+	filter func(domain.Tournament) bool,
+	kind string,
+	fetchAll func(context.Context, source.Source, []domain.Tournament) ([]tournamentResult, error),
+) ([]tournamentResult, error) {
+	if err := f.validate(); err != nil {
+		return nil, err
+	}
+	f.strict = ctx.Strict
+
+	src := ctx.dataSource()
+
+	tournaments, err := src.Tournaments(ctx.requestContext(), circuit)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.checkSkipped(tournaments.Skipped); err != nil {
+		return nil, fmt.Errorf("failed to map tournaments: %w", err)
+	}
+
+	filtered := make([]domain.Tournament, 0, len(tournaments.Items))
+	for _, t := range tournaments.Items {
+		if filter == nil || filter(t) {
+			filtered = append(filtered, t)
+		}
+	}
+
+	results, err := fetchAll(ctx.requestContext(), src, filtered)
+	if err != nil {
+		return nil, err
+	}
+
+	fetched := make([]tournamentResult, 0, len(results))
+	failed := make([]tournamentResult, 0)
+	for _, result := range results {
+		if result.err != nil {
+			failed = append(failed, result)
+			continue
+		}
+		// In strict mode skipped records already failed the tournament in fetchAll,
+		// so this only prints the warnings and cannot return an error
+		ctx.checkSkipped(result.skipped)
+		fetched = append(fetched, result)
+	}
+
+	if len(failed) > 0 {
+		f.printFailures(failed, len(results), kind)
+	}
+	if len(failed) > 0 && len(failed) == len(results) {
+		return nil, fmt.Errorf("failed to fetch %s for all %d tournaments", kind, len(results))
+	}
+
+	return fetched, nil
+}
+
+// fetchCircuits fetches the tournaments and matches of several circuits, see fetchCircuit
+func (f *FetchFlags) fetchCircuits(ctx *Context, circuits []string, filter func(domain.Tournament) bool) ([]domain.TournamentMatches, error) {
+	if len(circuits) == 1 {
+		return f.fetchCircuit(ctx, circuits[0], filter)
+	}
+
+	var all []domain.TournamentMatches
+	for _, circuit := range circuits {
+		tournaments, err := f.fetchCircuit(ctx, circuit, filter)
+		if err != nil {
+			return nil, fmt.Errorf("circuit %s: %w", circuit, err)
+		}
+		all = append(all, tournaments...)
+	}
+	return all, nil
+}
+
+// parseCircuits expands a circuit or a range of circuits (e.g., 2024..2026) into a list of circuits.
+// An empty value selects the current year.
+func parseCircuits(value string, now func() time.Time) ([]string, error) {
+	if value == "" {
+		return []string{fmt.Sprintf("%d", now().Year())}, nil
+	}
+
+	from, to, isRange := strings.Cut(value, "..")
+	if !isRange {
+		return []string{value}, nil
+	}
+
+	start, err := strconv.Atoi(from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid circuit range %q: start must be a year", value)
+	}
+	end, err := strconv.Atoi(to)
+	if err != nil {
+		return nil, fmt.Errorf("invalid circuit range %q: end must be a year", value)
+	}
+	if end < start {
+		return nil, fmt.Errorf("invalid circuit range %q: end is before start", value)
+	}
+
+	circuits := make([]string, 0, end-start+1)
+	for year := start; year <= end; year++ {
+		circuits = append(circuits, strconv.Itoa(year))
+	}
+	return circuits, nil
+}
+
+// fetchAllMatches fetches the matches of all tournaments, see fetchAll
+func (f *FetchFlags) fetchAllMatches(parent context.Context, src source.Source, tournaments []domain.Tournament) ([]tournamentResult, error) {
+	return f.fetchAll(parent, tournaments, "matches", func(ctx context.Context, tournament domain.Tournament) tournamentResult {
+		matches, err := src.TournamentMatches(ctx, tournament.ID)
+		if err == nil && f.strict && matches.Err() != nil {
+			err = fmt.Errorf("failed to map matches: %w", matches.Err())
+		}
+		return tournamentResult{tournament: tournament, matches: matches.Items, skipped: matches.Skipped, err: err}
+	})
+}
+
+// fetchAllBrackets fetches the brackets of all tournaments, see fetchAll
+func (f *FetchFlags) fetchAllBrackets(parent context.Context, src source.Source, tournaments []domain.Tournament) ([]tournamentResult, error) {
+	return f.fetchAll(parent, tournaments, "brackets", func(ctx context.Context, tournament domain.Tournament) tournamentResult {
+		brackets, err := src.TournamentBrackets(ctx, tournament.ID)
+		if err == nil && f.strict && brackets.Err() != nil {
+			err = fmt.Errorf("failed to map brackets: %w", brackets.Err())
+		}
+		return tournamentResult{tournament: tournament, brackets: brackets.Items, skipped: brackets.Skipped, err: err}
+	})
+}
+
+// fetchAll fetches the data of all tournaments with fetch using a bounded pool of workers.
+// Without --keep-going the first failure cancels all outstanding requests and is returned.
+// Results are returned in the order of the given tournaments.
+func (f *FetchFlags) fetchAll(
+	parent context.Context,
+	tournaments []domain.Tournament,
+	kind string,
+	fetch func(context.Context, domain.Tournament) tournamentResult,
+) ([]tournamentResult, error) {
+	reqCtx, cancel := context.WithCancel(parent)
+	defer cancel()
+
+	jobs := make(chan int)
+	results := make([]tournamentResult, len(tournaments))
+	done := make(chan int)
+
+	workers := f.Concurrency
+	if workers > len(tournaments) {
+		workers = len(tournaments)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range jobs {
+				results[index] = fetch(reqCtx, tournaments[index])
+				done <- index
+			}
+		}()
+	}
+
+	// Feed jobs until all are handed out or the fetch is cancelled
+	go func() {
+		defer close(jobs)
+		for i := range tournaments {
+			select {
+			case jobs <- i:
+			case <-reqCtx.Done():
+				return
+			}
+		}
+	}()
+
+	// Close done once all workers have exited
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	var firstErr error
+	for index := range done {
+		err := results[index].err
+		if err != nil && !f.KeepGoing && firstErr == nil {
+			firstErr = fmt.Errorf("failed to fetch %s for %s: %w", kind, tournaments[index].Name, err)
+			cancel()
+		}
+	}
+
+	if err := parent.Err(); err != nil {
+		return nil, fmt.Errorf("interrupted: %w", err)
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return results, nil
+}
+
+// printFailures writes a per-tournament summary of failed fetches to stderr
+func (f *FetchFlags) printFailures(failed []tournamentResult, total int, kind string) {
+	w := f.stderr
+	if w == nil {
+		w = os.Stderr
+	}
+
+	fmt.Fprintf(w, "Failed to fetch %s for %d of %d tournaments:\n", kind, len(failed), total)
+	for _, result := range failed {
+		fmt.Fprintf(w, "  - %s (%s): %v\n", result.tournament.Name, result.tournament.ID, result.err)
+	}
+}
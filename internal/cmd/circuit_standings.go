@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/points"
+)
+
+// CircuitStandingsCmd computes the circuit points leaderboard of a season
+type CircuitStandingsCmd struct {
+	Circuit     string        `help:"Circuit/year to compute points for (e.g., 2025, 2026)" default:""`
+	Region      string        `help:"Show only teams that played regional tournaments of this region (NA, EU, APAC, SAM, OCE, MENA, SSA)"`
+	PointsTable string        `name:"points-table" help:"YAML file with the points awarded per tournament type and placement (default from config)" type:"path"`
+	Limit       int           `help:"Maximum number of teams to return"`
+	Output      output.Format `help:"Output format (table, json, csv, yaml)" default:"table" short:"o"`
+
+	FetchFlags `embed:""`
+
+	// now is a function that returns the current time, can be overridden for testing
+	now func() time.Time `kong:"-"`
+}
+
+func (c *CircuitStandingsCmd) Run(ctx *Context) error {
+	if c.Limit < 0 {
+		return fmt.Errorf("limit cannot be negative")
+	}
+	if c.now == nil {
+		c.now = time.Now
+	}
+
+	path := c.PointsTable
+	if path == "" {
+		path = ctx.settings().PointsTable
+	}
+	if path == "" {
+		return fmt.Errorf("no points table configured, pass --points-table or set points_table in the configuration file")
+	}
+	table, err := points.Load(path)
+	if err != nil {
+		return err
+	}
+
+	// Tournaments that have not started yet have no placements
+	now := c.now()
+	started := func(t domain.Tournament) bool {
+		return !t.IsUpcoming(now)
+	}
+	tournaments, err := c.fetchCircuitBrackets(ctx, circuitOrCurrent(c.Circuit, c.now), started)
+	if err != nil {
+		return err
+	}
+
+	standings := points.Compute(tournaments, table)
+
+	if c.Region != "" {
+		filtered := make([]points.Standing, 0, len(standings))
+		for _, s := range standings {
+			if s.HasRegion(c.Region) {
+				filtered = append(filtered, s)
+			}
+		}
+		standings = filtered
+		points.Rank(standings)
+	}
+
+	if c.Limit > 0 && len(standings) > c.Limit {
+		standings = standings[:c.Limit]
+	}
+
+	formatter, err := output.GetPointsFormatter(c.Output)
+	if err != nil {
+		return fmt.Errorf("failed to get formatter: %w", err)
+	}
+
+	if err := formatter.Format(os.Stdout, standings); err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/config"
+	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitStandingsCmd_Run_Snapshot(t *testing.T) {
+	src, err := source.NewSnapshotSource("testdata/snapshot")
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "points.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("Major:\n  1: 300\n  2: 240\n  3-4: 180\n  5-8: 120\n"), 0o644))
+
+	now := func() time.Time { return time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC) }
+
+	t.Run("formats", func(t *testing.T) {
+		ctx := &Context{Source: src}
+		for _, format := range []output.Format{output.FormatTable, output.FormatJSON, output.FormatCSV, output.FormatYAML} {
+			cmd := &CircuitStandingsCmd{PointsTable: path, Output: format, now: now}
+			require.NoError(t, cmd.Run(ctx))
+		}
+	})
+
+	t.Run("points table from config", func(t *testing.T) {
+		ctx := &Context{Source: src, Config: &config.Config{PointsTable: path}}
+		cmd := &CircuitStandingsCmd{Circuit: "2026", Region: "EU", Output: output.FormatTable, now: now}
+		require.NoError(t, cmd.Run(ctx))
+	})
+
+	t.Run("no points table", func(t *testing.T) {
+		cmd := &CircuitStandingsCmd{Output: output.FormatTable, now: now}
+		assert.EqualError(t, cmd.Run(&Context{Source: src}), "no points table configured, pass --points-table or set points_table in the configuration file")
+	})
+
+	t.Run("negative limit", func(t *testing.T) {
+		cmd := &CircuitStandingsCmd{Limit: -1, PointsTable: path, now: now}
+		assert.EqualError(t, cmd.Run(&Context{Source: src}), "limit cannot be negative")
+	})
+}
@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCircuits(t *testing.T) {
+	now := func() time.Time { return time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		value    string
+		expected []string
+		err      string
+	}{
+		{value: "", expected: []string{"2026"}},
+		{value: "2025", expected: []string{"2025"}},
+		{value: "2024..2026", expected: []string{"2024", "2025", "2026"}},
+		{value: "2025..2025", expected: []string{"2025"}},
+		{value: "2026..2024", err: `invalid circuit range "2026..2024": end is before start`},
+		{value: "abc..2024", err: `invalid circuit range "abc..2024": start must be a year`},
+		{value: "2024..", err: `invalid circuit range "2024..": end must be a year`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			circuits, err := parseCircuits(tt.value, now)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, circuits)
+		})
+	}
+}
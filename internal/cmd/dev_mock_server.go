@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/mockserver"
+)
+
+// DevMockServerCmd serves the Blast API endpoints used by the CLI from fixture files
+type DevMockServerCmd struct {
+	Fixtures string  `arg:"" help:"Fixtures directory" type:"existingdir"`
+	Addr     string  `help:"Address to listen on" default:"127.0.0.1:8080"`
+	Scenario string  `help:"Scenario file (defaults to scenario.yaml in the fixtures directory if present)" type:"path"`
+	Speed    float64 `help:"Speed factor for scenario steps (e.g., 60 turns minutes into seconds)" default:"1"`
+}
+
+func (d *DevMockServerCmd) Run(ctx *Context) error {
+	scenarioPath := d.Scenario
+	if scenarioPath == "" {
+		defaultPath := filepath.Join(d.Fixtures, mockserver.ScenarioFile)
+		if _, err := os.Stat(defaultPath); err == nil {
+			scenarioPath = defaultPath
+		}
+	}
+
+	var scenario *mockserver.Scenario
+	if scenarioPath != "" {
+		loaded, err := mockserver.LoadScenario(scenarioPath)
+		if err != nil {
+			return err
+		}
+		scenario = loaded
+	}
+
+	listener, err := net.Listen("tcp", d.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+
+	server := &http.Server{
+		Handler:           mockserver.New(d.Fixtures, scenario, d.Speed).Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	fmt.Fprintf(os.Stderr, "Serving Blast API mock at http://%s\n", listener.Addr())
+	fmt.Fprintf(os.Stderr, "Point the CLI at it with --api-url http://%s\n", listener.Addr())
+	if scenario != nil {
+		fmt.Fprintf(os.Stderr, "Scenario with %d steps loaded, POST /_mock/advance to skip ahead\n", len(scenario.Steps))
+	}
+
+	go func() {
+		<-ctx.requestContext().Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+
+	if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("mock server failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/teams"
+)
+
+// H2HCmd shows the head-to-head history between two teams
+type H2HCmd struct {
+	TeamA   string           `arg:"" name:"teamA" help:"First team (UUID, name or shorthand)"`
+	TeamB   string           `arg:"" name:"teamB" help:"Second team (UUID, name or shorthand)"`
+	Circuit string           `help:"Circuit/year or range of circuits to search (e.g., 2026, 2024..2026)" default:""`
+	Last    int              `help:"Number of most recent meetings to show" default:"10"`
+	Output  output.H2HFormat `help:"Output format (table, json, yaml)" default:"table" short:"o"`
+
+	FetchFlags `embed:""`
+
+	// now is a function that returns the current time, can be overridden for testing
+	now func() time.Time `kong:"-"`
+}
+
+func (h *H2HCmd) Run(ctx *Context) error {
+	if h.Last < 0 {
+		return fmt.Errorf("last cannot be negative")
+	}
+	if h.now == nil {
+		h.now = time.Now
+	}
+
+	circuits, err := parseCircuits(h.Circuit, h.now)
+	if err != nil {
+		return err
+	}
+
+	tournaments, err := h.fetchCircuits(ctx, circuits, nil)
+	if err != nil {
+		return err
+	}
+
+	// Both queries are resolved to exactly one team, so a partial name cannot merge several teams
+	records := teams.Aggregate(tournaments, 0)
+	teamA, err := findTeam(records, h.TeamA)
+	if err != nil {
+		return err
+	}
+	teamB, err := findTeam(records, h.TeamB)
+	if err != nil {
+		return err
+	}
+	if teamA.UUID == teamB.UUID {
+		return fmt.Errorf("%q and %q are the same team: %s", h.TeamA, h.TeamB, teamA.Name)
+	}
+
+	h2h := teams.CompareHeadToHead(tournaments, teamA, teamB, h.Last)
+
+	formatter, err := output.GetH2HFormatter(h.Output)
+	if err != nil {
+		return fmt.Errorf("failed to get formatter: %w", err)
+	}
+
+	if err := formatter.Format(os.Stdout, h2h); err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestH2HCmd_Run_Snapshot(t *testing.T) {
+	src, err := source.NewSnapshotSource("../source/testdata/snapshot")
+	require.NoError(t, err)
+
+	var stderr bytes.Buffer
+	ctx := &Context{Source: src, stderr: &stderr}
+
+	cmd := &H2HCmd{TeamA: "vit", TeamB: "kc", Circuit: "2025", Last: 10, Output: output.H2HFormatJSON}
+	require.NoError(t, cmd.Run(ctx))
+
+	// Circuits of a range that are missing from the snapshot fail
+	cmd.Circuit = "2024..2025"
+	assert.EqualError(t, cmd.Run(ctx), "circuit 2024: circuit not found: 2024")
+
+	// Queries must identify exactly one team each, and two different teams
+	cmd = &H2HCmd{TeamA: "e", TeamB: "kc", Circuit: "2025", Last: 10, Output: output.H2HFormatJSON}
+	assert.ErrorContains(t, cmd.Run(ctx), `team "e" is ambiguous, matches: `)
+
+	cmd = &H2HCmd{TeamA: "vit", TeamB: "Team Vitality", Circuit: "2025", Last: 10, Output: output.H2HFormatJSON}
+	assert.EqualError(t, cmd.Run(ctx), `"vit" and "Team Vitality" are the same team: Team Vitality`)
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLogger(t *testing.T) {
+	t.Run("debug disabled hides debug messages", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := newLogger(&buf, "text", false)
+
+		logger.Debug("api request", "status", 200)
+		logger.Warn("skipped record")
+
+		assert.NotContains(t, buf.String(), "api request")
+		assert.Contains(t, buf.String(), "skipped record")
+	})
+
+	t.Run("json format", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := newLogger(&buf, "json", true)
+
+		logger.Debug("api request", "status", 200)
+
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+		assert.Equal(t, "api request", entry["msg"])
+		assert.Equal(t, float64(200), entry["status"])
+	})
+}
+
+func TestOpenLogOutput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rlcs.log")
+
+	w, closeLog, err := openLogOutput(path)
+	require.NoError(t, err)
+	newLogger(w, "text", true).Debug("hello")
+	closeLog()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.True(t, strings.Contains(string(data), "msg=hello"))
+}
@@ -1,16 +1,12 @@
 package cmd
 
 import (
-	"encoding/json"
 	"fmt"
 	"io"
-	"net/http"
 	"os"
 	"time"
 
-	"github.com/mgranderath/rlcs-cli/internal/api/blast"
 	"github.com/mgranderath/rlcs-cli/internal/domain"
-	"github.com/mgranderath/rlcs-cli/internal/mapper"
 	"github.com/mgranderath/rlcs-cli/internal/output"
 )
 
@@ -18,62 +14,45 @@ import (
 type MatchesGetCmd struct {
 	MatchID string               `arg:"" help:"Match ID"`
 	Output  output.MatchesFormat `help:"Output format (table, json, yaml)" default:"table" short:"o"`
+	Watch   WatchFlag            `help:"Keep polling and show score changes, optionally with the interval while live (e.g., --watch 10s)"`
+
+	// now is a function that returns the current time, can be overridden for testing
+	now func() time.Time `kong:"-"`
 }
 
 func (g *MatchesGetCmd) Run(ctx *Context) error {
-	url := fmt.Sprintf("%s/matches/%s/detailed", blast.BaseURL, g.MatchID)
-
-	client := &http.Client{
-		Timeout: 10 * time.Second,
-	}
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+	if g.now == nil {
+		g.now = time.Now
 	}
 
-	req.Header.Set("Accept", "application/json")
-
-	resp, err := client.Do(req)
+	// Get the appropriate formatter
+	formatter, err := output.GetMatchesFormatter(g.Output, g.now)
 	if err != nil {
-		return fmt.Errorf("failed to make request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("match not found: %s", g.MatchID)
-	}
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return fmt.Errorf("failed to get formatter: %w", err)
 	}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
-	}
+	fetch := func() ([]domain.Match, func(io.Writer) error, error) {
+		match, err := ctx.dataSource().Match(ctx.requestContext(), g.MatchID)
+		if err != nil {
+			return nil, nil, err
+		}
 
-	var apiMatch blast.MatchResponse
-	if err := json.Unmarshal(body, &apiMatch); err != nil {
-		return fmt.Errorf("failed to parse JSON: %w", err)
+		// Wrap single match in a slice for formatter compatibility
+		matches := []domain.Match{match}
+		return matches, func(w io.Writer) error { return formatter.Format(w, matches) }, nil
 	}
 
-	// Map API response to domain model
-	match, err := mapper.ToDomainMatchFromDetailResponse(apiMatch)
-	if err != nil {
-		return fmt.Errorf("failed to map match: %w", err)
+	if g.Watch.Enabled {
+		return newWatcher(ctx, g.Watch).run(ctx.requestContext(), fetch)
 	}
 
-	// Wrap single match in a slice for formatter compatibility
-	matches := []domain.Match{match}
-
-	// Get the appropriate formatter
-	formatter, err := output.GetMatchesFormatter(g.Output)
+	_, render, err := fetch()
 	if err != nil {
-		return fmt.Errorf("failed to get formatter: %w", err)
+		return err
 	}
 
 	// Output using the selected formatter
-	if err := formatter.Format(os.Stdout, matches); err != nil {
+	if err := render(os.Stdout); err != nil {
 		return fmt.Errorf("failed to format output: %w", err)
 	}
 
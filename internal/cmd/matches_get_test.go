@@ -2,8 +2,10 @@ package cmd
 
 import (
 	"testing"
+	"time"
 
 	"github.com/h2non/gock"
+	"github.com/mgranderath/rlcs-cli/internal/api/blast"
 	"github.com/mgranderath/rlcs-cli/internal/output"
 	"github.com/stretchr/testify/assert"
 )
@@ -97,13 +99,14 @@ func TestMatchesGetCmd_Run_HTTPMock(t *testing.T) {
 	t.Run("500 response", func(t *testing.T) {
 		gock.New("https://api.blast.tv").
 			Get("/v2/matches/test-id/detailed").
+			Times(3).
 			Reply(500)
 
 		cmd := &MatchesGetCmd{
 			MatchID: "test-id",
 			Output:  output.MatchesFormatTable,
 		}
-		ctx := &Context{Debug: false}
+		ctx := &Context{Debug: false, Client: blast.NewClient(blast.WithRetry(2, time.Millisecond))}
 
 		err := cmd.Run(ctx)
 		assert.Error(t, err)
@@ -1,18 +1,15 @@
 package cmd
 
 import (
-	"encoding/json"
 	"fmt"
 	"io"
-	"net/http"
 	"os"
 	"strings"
 	"time"
 
-	"github.com/mgranderath/rlcs-cli/internal/api/blast"
 	"github.com/mgranderath/rlcs-cli/internal/domain"
-	"github.com/mgranderath/rlcs-cli/internal/mapper"
 	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/teams"
 )
 
 // MatchesListCmd retrieves all matches for a tournament
@@ -24,6 +21,10 @@ type MatchesListCmd struct {
 	Team          string               `help:"Filter by team name (case-insensitive partial match)"`
 	MatchType     string               `help:"Filter by match type (e.g., BO5, BO7)"`
 	Output        output.MatchesFormat `help:"Output format (table, json, yaml)" default:"table" short:"o"`
+	Watch         WatchFlag            `help:"Keep polling and show score changes, optionally with the interval while live (e.g., --watch 10s)"`
+
+	// now is a function that returns the current time, can be overridden for testing
+	now func() time.Time `kong:"-"`
 }
 
 func (g *MatchesListCmd) matchesFilters(match domain.Match) bool {
@@ -39,15 +40,8 @@ func (g *MatchesListCmd) matchesFilters(match domain.Match) bool {
 	}
 
 	// Team filter (case-insensitive partial match on name or shorthand)
-	if g.Team != "" {
-		teamLower := strings.ToLower(g.Team)
-		teamAMatch := strings.Contains(strings.ToLower(match.TeamA.Name), teamLower) ||
-			strings.Contains(strings.ToLower(match.TeamA.Shorthand), teamLower)
-		teamBMatch := strings.Contains(strings.ToLower(match.TeamB.Name), teamLower) ||
-			strings.Contains(strings.ToLower(match.TeamB.Shorthand), teamLower)
-		if !teamAMatch && !teamBMatch {
-			return false
-		}
+	if g.Team != "" && !teams.Matches(match.TeamA, g.Team) && !teams.Matches(match.TeamB, g.Team) {
+		return false
 	}
 
 	// Match type filter (case-insensitive)
@@ -74,59 +68,45 @@ func (g *MatchesListCmd) Run(ctx *Context) error {
 		return fmt.Errorf("cannot use multiple status filters together (completed-only, live-only, upcoming-only are mutually exclusive)")
 	}
 
-	url := fmt.Sprintf("%s/games/rl/tournaments/%s/matches", blast.BaseURL, g.TournamentID)
-
-	client := &http.Client{
-		Timeout: 10 * time.Second,
-	}
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+	if g.now == nil {
+		g.now = time.Now
 	}
 
-	req.Header.Set("Accept", "application/json")
-
-	resp, err := client.Do(req)
+	// Get the appropriate formatter
+	formatter, err := output.GetMatchesFormatter(g.Output, g.now)
 	if err != nil {
-		return fmt.Errorf("failed to make request: %w", err)
+		return fmt.Errorf("failed to get formatter: %w", err)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("tournament not found: %s", g.TournamentID)
-	}
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
+	// Changes are detected before the status filters, so that a series leaving the list is still reported
+	fetch := func() ([]domain.Match, func(io.Writer) error, error) {
+		result, err := ctx.dataSource().TournamentMatches(ctx.requestContext(), g.TournamentID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := ctx.checkSkipped(result.Skipped); err != nil {
+			return nil, nil, fmt.Errorf("failed to map matches: %w", err)
+		}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
-	}
+		// Apply filters
+		matches := g.applyFilters(result.Items)
 
-	var apiMatches []blast.MatchResponse
-	if err := json.Unmarshal(body, &apiMatches); err != nil {
-		return fmt.Errorf("failed to parse JSON: %w", err)
+		watched := *g
+		watched.CompletedOnly, watched.LiveOnly, watched.UpcomingOnly = false, false, false
+		return watched.applyFilters(result.Items), func(w io.Writer) error { return formatter.Format(w, matches) }, nil
 	}
 
-	// Map API response to domain model
-	matches, err := mapper.ToDomainMatchesFromResponse(apiMatches)
-	if err != nil {
-		return fmt.Errorf("failed to map matches: %w", err)
+	if g.Watch.Enabled {
+		return newWatcher(ctx, g.Watch).run(ctx.requestContext(), fetch)
 	}
 
-	// Apply filters
-	matches = g.applyFilters(matches)
-
-	// Get the appropriate formatter
-	formatter, err := output.GetMatchesFormatter(g.Output)
+	_, render, err := fetch()
 	if err != nil {
-		return fmt.Errorf("failed to get formatter: %w", err)
+		return err
 	}
 
 	// Output using the selected formatter
-	if err := formatter.Format(os.Stdout, matches); err != nil {
+	if err := render(os.Stdout); err != nil {
 		return fmt.Errorf("failed to format output: %w", err)
 	}
 
@@ -1,9 +1,12 @@
 package cmd
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	"github.com/h2non/gock"
+	"github.com/mgranderath/rlcs-cli/internal/api/blast"
 	"github.com/mgranderath/rlcs-cli/internal/domain"
 	"github.com/mgranderath/rlcs-cli/internal/output"
 	"github.com/stretchr/testify/assert"
@@ -257,13 +260,14 @@ func TestMatchesListCmd_Run_HTTPMock(t *testing.T) {
 	t.Run("500 response", func(t *testing.T) {
 		gock.New("https://api.blast.tv").
 			Get("/v2/games/rl/tournaments/test-id/matches").
+			Times(3).
 			Reply(500)
 
 		cmd := &MatchesListCmd{
 			TournamentID: "test-id",
 			Output:       output.MatchesFormatTable,
 		}
-		ctx := &Context{Debug: false}
+		ctx := &Context{Debug: false, Client: blast.NewClient(blast.WithRetry(2, time.Millisecond))}
 
 		err := cmd.Run(ctx)
 		assert.Error(t, err)
@@ -324,6 +328,51 @@ func TestMatchesListCmd_Run_HTTPMock(t *testing.T) {
 		err := cmd.Run(ctx)
 		assert.NoError(t, err)
 	})
+
+	t.Run("invalid match is skipped with a warning", func(t *testing.T) {
+		for _, strict := range []bool{false, true} {
+			gock.New("https://api.blast.tv").
+				Get("/v2/games/rl/tournaments/bad-record-tournament/matches").
+				Reply(200).
+				JSON([]map[string]interface{}{
+					{
+						"id":          "match-1",
+						"name":        "Match 1",
+						"scheduledAt": "2026-01-15T12:00:00Z",
+						"type":        "BO5",
+						"teamA":       map[string]interface{}{"id": "a", "name": "Team A"},
+						"teamB":       map[string]interface{}{"id": "b", "name": "Team B"},
+						"maps":        []map[string]interface{}{},
+					},
+					{
+						"id":          "match-2",
+						"name":        "Match 2",
+						"scheduledAt": "TBD",
+						"type":        "BO5",
+						"teamA":       map[string]interface{}{"id": "c", "name": "Team C"},
+						"teamB":       map[string]interface{}{"id": "d", "name": "Team D"},
+						"maps":        []map[string]interface{}{},
+					},
+				})
+
+			cmd := &MatchesListCmd{
+				TournamentID: "bad-record-tournament",
+				Output:       output.MatchesFormatJSON,
+			}
+			var stderr bytes.Buffer
+			ctx := &Context{Strict: strict, stderr: &stderr}
+
+			err := cmd.Run(ctx)
+			if strict {
+				assert.EqualError(t, err, `failed to map matches: failed to map match match-2: failed to parse scheduled time: unrecognized timestamp "TBD"`)
+				assert.Empty(t, stderr.String())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "Warning: skipped match match-2: failed to parse scheduled time: unrecognized timestamp \"TBD\"\n", stderr.String())
+			}
+		}
+		assert.True(t, gock.IsDone())
+	})
 }
 
 func TestMatchesListCmd_Run_Validation(t *testing.T) {
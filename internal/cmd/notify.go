@@ -0,0 +1,290 @@
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/api/blast"
+	"github.com/mgranderath/rlcs-cli/internal/config"
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/notify"
+	"github.com/mgranderath/rlcs-cli/internal/ratings"
+	"github.com/mgranderath/rlcs-cli/internal/watch"
+)
+
+// WebhookFlags add webhooks to the ones of the configuration file
+type WebhookFlags struct {
+	Webhook       []string `help:"Webhook URL to post events to in addition to the configured ones (repeatable)"`
+	WebhookFormat string   `name:"webhook-format" help:"Payload format of the --webhook URLs (json, discord, slack)" enum:"json,discord,slack" default:"json"`
+	Team          []string `help:"Only post the series of these teams to the --webhook URLs (UUID, name or shorthand, repeatable)"`
+}
+
+// webhooks returns the configured webhooks followed by the ones given on the command line
+func (f *WebhookFlags) webhooks(cfg config.Notify) ([]notify.Webhook, error) {
+	var webhooks []notify.Webhook
+	for _, w := range cfg.Webhooks {
+		webhook := notify.Webhook{
+			Name:   w.Name,
+			URL:    w.URL,
+			Format: notify.Format(strings.ToLower(firstNonEmpty(w.Format, string(notify.FormatJSON)))),
+			Teams:  w.Teams,
+		}
+		for _, event := range w.Events {
+			webhook.Kinds = append(webhook.Kinds, notify.Kind(strings.ToLower(event)))
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	for _, u := range f.Webhook {
+		webhooks = append(webhooks, notify.Webhook{URL: u, Format: notify.Format(firstNonEmpty(f.WebhookFormat, string(notify.FormatJSON))), Teams: f.Team})
+	}
+
+	seen := make(map[string]bool, len(webhooks))
+	for i := range webhooks {
+		if webhooks[i].Name == "" {
+			webhooks[i].Name = webhookName(webhooks[i].URL)
+		}
+		if err := webhooks[i].Validate(); err != nil {
+			return nil, err
+		}
+		if seen[webhooks[i].Name] {
+			return nil, fmt.Errorf("duplicate webhook name: %s", webhooks[i].Name)
+		}
+		seen[webhooks[i].Name] = true
+	}
+	return webhooks, nil
+}
+
+// webhookName derives a stable name from the URL of a webhook without revealing its path,
+// which often holds the secret of the webhook
+func webhookName(rawURL string) string {
+	sum := sha256.Sum256([]byte(rawURL))
+	host := rawURL
+	if u, err := url.Parse(rawURL); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	return host + "#" + hex.EncodeToString(sum[:4])
+}
+
+// NotifyRunCmd polls the matches of a circuit and posts lifecycle events to webhooks
+type NotifyRunCmd struct {
+	Circuit  string        `help:"Circuit/year or range of circuits to watch and rate teams on (e.g., 2026, 2025..2026)" default:""`
+	Interval time.Duration `help:"Time between polls while a series is live, polling slows down while nothing is live" default:"1m"`
+	Once     bool          `help:"Poll once and exit, e.g. when run from cron"`
+	DryRun   bool          `name:"dry-run" help:"Print events instead of posting them, the state file is not updated"`
+	State    string        `help:"File remembering the events that were sent (defaults to notify.json in the user cache dir)" type:"path"`
+
+	WebhookFlags `embed:""`
+	FetchFlags   `embed:""`
+
+	// now is a function that returns the current time, can be overridden for testing
+	now func() time.Time `kong:"-"`
+	// sender posts events, defaults to notify.NewSender()
+	sender *notify.Sender `kong:"-"`
+	// out receives the log of sent events, defaults to os.Stdout
+	out io.Writer `kong:"-"`
+}
+
+func (n *NotifyRunCmd) Run(ctx *Context) error {
+	if n.Interval <= 0 {
+		return fmt.Errorf("interval must be positive")
+	}
+	if n.now == nil {
+		loc := ctx.location()
+		n.now = func() time.Time { return time.Now().In(loc) }
+	}
+	if n.sender == nil {
+		n.sender = notify.NewSender()
+	}
+	if n.out == nil {
+		n.out = os.Stdout
+	}
+
+	settings := ctx.settings().Notify
+	webhooks, err := n.webhooks(settings)
+	if err != nil {
+		return err
+	}
+	if len(webhooks) == 0 && !n.DryRun {
+		return fmt.Errorf("no webhooks configured, add them to the notify section of the config file or pass --webhook")
+	}
+
+	opts := notify.Options{UpsetProbability: notify.DefaultUpsetProbability}
+	if settings.UpsetProbability != 0 {
+		opts.UpsetProbability = settings.UpsetProbability
+	}
+	if opts.UpsetProbability <= 0 || opts.UpsetProbability >= 0.5 {
+		return fmt.Errorf("upset_probability must be between 0 and 0.5")
+	}
+	ratingOpts, err := (&RatingFlags{}).options(ctx.settings().Ratings)
+	if err != nil {
+		return err
+	}
+	opts.System = ratingOpts.System
+
+	circuits, err := parseCircuits(n.Circuit, n.now)
+	if err != nil {
+		return err
+	}
+
+	statePath := n.State
+	if statePath == "" {
+		dir, err := blast.DefaultCacheDir()
+		if err != nil {
+			return err
+		}
+		statePath = filepath.Join(dir, "notify.json")
+	}
+	state, err := notify.LoadState(statePath)
+	if err != nil {
+		return err
+	}
+
+	for {
+		tournaments, err := n.fetchCircuits(ctx, circuits, nil)
+		if errors.Is(err, context.Canceled) {
+			return nil
+		}
+		if err != nil && n.Once {
+			return err
+		}
+
+		var matches []domain.Match
+		if err != nil {
+			fmt.Fprintf(ctx.warnings(), "Warning: poll failed: %v\n", err)
+		} else {
+			opts.Ratings = ratings.Replay(tournaments, ratingOpts)
+			n.dispatch(ctx, webhooks, state, notify.Detect(tournaments, state, opts, n.now()))
+			if !n.DryRun {
+				state.Prune(n.now())
+				if err := state.Save(statePath); err != nil {
+					return err
+				}
+			}
+			for _, t := range tournaments {
+				matches = append(matches, t.Matches...)
+			}
+		}
+
+		if n.Once {
+			return nil
+		}
+		select {
+		case <-ctx.requestContext().Done():
+			return nil
+		case <-time.After(watch.NextPoll(matches, n.Interval, n.now())):
+		}
+	}
+}
+
+// dispatch posts the events to every webhook accepting them. An event is handled once every webhook
+// accepting it received it, failed deliveries are retried with the next poll. On the first run the
+// events of the current state are only recorded, so that the history of the circuit is not sent.
+func (n *NotifyRunCmd) dispatch(ctx *Context, webhooks []notify.Webhook, state *notify.State, events []notify.Event) {
+	if state.Empty() {
+		for _, event := range events {
+			state.Handle(event, n.now())
+		}
+		fmt.Fprintf(n.out, "%s Recorded %d past events, only new events are sent from now on\n", n.timestamp(), len(events))
+		return
+	}
+
+	for _, event := range events {
+		if n.DryRun {
+			fmt.Fprintf(n.out, "%s %s: %s\n", n.timestamp(), event.Kind, event.Message())
+			state.Handle(event, n.now())
+			continue
+		}
+
+		delivered := true
+		for _, webhook := range webhooks {
+			if !webhook.Accepts(event) || state.WasDelivered(webhook.Name, event) {
+				continue
+			}
+			if err := n.sender.Send(ctx.requestContext(), webhook, event); err != nil {
+				fmt.Fprintf(ctx.warnings(), "Warning: %v\n", err)
+				delivered = false
+				continue
+			}
+			state.Deliver(webhook.Name, event, n.now())
+			fmt.Fprintf(n.out, "%s %s: %s -> %s\n", n.timestamp(), event.Kind, event.Message(), webhook.Name)
+		}
+		if delivered {
+			state.Handle(event, n.now())
+		}
+	}
+}
+
+func (n *NotifyRunCmd) timestamp() string {
+	return n.now().Format("15:04:05")
+}
+
+// NotifyTestCmd posts a sample event to every webhook
+type NotifyTestCmd struct {
+	WebhookFlags `embed:""`
+
+	// sender posts events, defaults to notify.NewSender()
+	sender *notify.Sender `kong:"-"`
+	// out receives the result per webhook, defaults to os.Stdout
+	out io.Writer `kong:"-"`
+}
+
+func (n *NotifyTestCmd) Run(ctx *Context) error {
+	if n.sender == nil {
+		n.sender = notify.NewSender()
+	}
+	if n.out == nil {
+		n.out = os.Stdout
+	}
+
+	webhooks, err := n.webhooks(ctx.settings().Notify)
+	if err != nil {
+		return err
+	}
+	if len(webhooks) == 0 {
+		return fmt.Errorf("no webhooks configured, add them to the notify section of the config file or pass --webhook")
+	}
+
+	event := sampleEvent()
+	failed := 0
+	for _, webhook := range webhooks {
+		if err := n.sender.Send(ctx.requestContext(), webhook, event); err != nil {
+			fmt.Fprintf(n.out, "FAIL %s: %v\n", webhook.Name, err)
+			failed++
+			continue
+		}
+		fmt.Fprintf(n.out, "OK   %s (%s)\n", webhook.Name, webhook.Format)
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to post to %d of %d webhooks", failed, len(webhooks))
+	}
+	return nil
+}
+
+// sampleEvent returns the event posted by notify test
+func sampleEvent() notify.Event {
+	return notify.Event{
+		ID:             "test/won",
+		Kind:           notify.KindSeriesWon,
+		TournamentID:   "test",
+		TournamentName: "rlcs-cli test notification",
+		MatchUUID:      "test",
+		Stage:          "Grand Final",
+		Type:           "BO7",
+		ScheduledAt:    time.Now().UTC().Truncate(time.Minute),
+		TeamA:          domain.MatchTeam{UUID: "team-a", Name: "Team A", Shorthand: "A"},
+		TeamB:          domain.MatchTeam{UUID: "team-b", Name: "Team B", Shorthand: "B"},
+		TeamAScore:     4,
+		TeamBScore:     2,
+		Winner:         "Team A",
+		Loser:          "Team B",
+	}
+}
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/config"
+	"github.com/mgranderath/rlcs-cli/internal/notify"
+	"github.com/mgranderath/rlcs-cli/internal/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// webhookServer records the kinds of the events posted to it
+func webhookServer(t *testing.T) (*httptest.Server, func() []string) {
+	var mu sync.Mutex
+	var kinds []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var body struct {
+			Kind string `json:"kind"`
+		}
+		require.NoError(t, json.Unmarshal(data, &body))
+		mu.Lock()
+		kinds = append(kinds, body.Kind)
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+	return server, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), kinds...)
+	}
+}
+
+func TestNotifyRunCmd_Run_Snapshot(t *testing.T) {
+	src, err := source.NewSnapshotSource("testdata/snapshot")
+	require.NoError(t, err)
+	server, posted := webhookServer(t)
+
+	ctx := &Context{Source: src, Location: time.UTC}
+	now := func() time.Time { return time.Date(2026, 3, 4, 18, 0, 0, 0, time.UTC) }
+	statePath := filepath.Join(t.TempDir(), "notify.json")
+	run := func() string {
+		var out bytes.Buffer
+		cmd := &NotifyRunCmd{Interval: time.Minute, Once: true, State: statePath, WebhookFlags: WebhookFlags{Webhook: []string{server.URL}}, now: now, out: &out}
+		require.NoError(t, cmd.Run(ctx))
+		return out.String()
+	}
+
+	// The first run only records the current state
+	assert.Contains(t, run(), "Recorded 37 past events")
+	assert.Empty(t, posted())
+
+	// The final was announced at another time, so it was rescheduled
+	state, err := notify.LoadState(statePath)
+	require.NoError(t, err)
+	state.Series["p-final"] = notify.Series{Scheduled: time.Date(2026, 3, 5, 16, 0, 0, 0, time.UTC)}
+	require.NoError(t, state.Save(statePath))
+
+	out := run()
+	assert.Equal(t, []string{"series_rescheduled"}, posted())
+	assert.Contains(t, out, "series_rescheduled: Team Vitality vs TBD moved from 2026-03-05 16:00 to 2026-03-05 18:00 UTC -> 127.0.0.1:")
+
+	// Restarts do not send events again
+	run()
+	assert.Len(t, posted(), 1)
+}
+
+func TestNotifyRunCmd_Run_Errors(t *testing.T) {
+	ctx := &Context{}
+
+	cmd := &NotifyRunCmd{Interval: time.Minute, Once: true}
+	assert.EqualError(t, cmd.Run(ctx), "no webhooks configured, add them to the notify section of the config file or pass --webhook")
+
+	cmd = &NotifyRunCmd{Once: true}
+	assert.EqualError(t, cmd.Run(ctx), "interval must be positive")
+
+	cmd = &NotifyRunCmd{Interval: time.Minute, WebhookFlags: WebhookFlags{Webhook: []string{"localhost"}}}
+	assert.ErrorContains(t, cmd.Run(ctx), "url must start with http:// or https://")
+
+	ctx = &Context{Config: &config.Config{Notify: config.Notify{UpsetProbability: 0.7}}}
+	cmd = &NotifyRunCmd{Interval: time.Minute, DryRun: true}
+	assert.EqualError(t, cmd.Run(ctx), "upset_probability must be between 0 and 0.5")
+}
+
+func TestNotifyTestCmd_Run(t *testing.T) {
+	server, posted := webhookServer(t)
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer failing.Close()
+
+	ctx := &Context{Config: &config.Config{Notify: config.Notify{Webhooks: []config.Webhook{{Name: "team", URL: server.URL, Format: "Discord"}}}}}
+
+	var out bytes.Buffer
+	cmd := &NotifyTestCmd{out: &out}
+	require.NoError(t, cmd.Run(ctx))
+	assert.Equal(t, "OK   team (discord)\n", out.String())
+
+	cmd = &NotifyTestCmd{WebhookFlags: WebhookFlags{Webhook: []string{failing.URL}}, out: &out}
+	assert.EqualError(t, cmd.Run(ctx), "failed to post to 1 of 2 webhooks")
+	assert.Len(t, posted(), 2)
+}
+
+func TestWebhookName(t *testing.T) {
+	name := webhookName("https://discord.com/api/webhooks/123/secret")
+	assert.Regexp(t, `^discord\.com#[0-9a-f]{8}$`, name)
+	assert.NotEqual(t, name, webhookName("https://discord.com/api/webhooks/456/secret"))
+}
@@ -0,0 +1,231 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/config"
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/ratings"
+)
+
+// RatingFlags select the circuits replayed by the rating engine and its parameters.
+// Parameters that are not given fall back to the ratings section of the configuration file.
+type RatingFlags struct {
+	Circuit string  `help:"Circuit/year or range of circuits to replay (e.g., 2026, 2024..2026)" default:""`
+	System  string  `help:"Rating system (elo, glicko2), default from config else elo" enum:",elo,glicko2" default:""`
+	KFactor float64 `name:"k-factor" help:"Elo K-factor, default from config else 32"`
+	By      string  `help:"Rate completed series or individual games (series, game), default from config else series" enum:",series,game" default:""`
+}
+
+// options merges the flags with the configuration file
+func (r *RatingFlags) options(cfg config.Ratings) (ratings.Options, error) {
+	opts := ratings.DefaultOptions()
+
+	if system := firstNonEmpty(r.System, cfg.System); system != "" {
+		opts.System = ratings.System(strings.ToLower(system))
+	}
+	if r.KFactor != 0 {
+		opts.KFactor = r.KFactor
+	} else if cfg.KFactor != 0 {
+		opts.KFactor = cfg.KFactor
+	}
+	if cfg.Initial != 0 {
+		opts.Initial = cfg.Initial
+	}
+
+	switch by := strings.ToLower(firstNonEmpty(r.By, cfg.By)); by {
+	case "", "series":
+	case "game":
+		opts.ByGame = true
+	default:
+		return ratings.Options{}, fmt.Errorf("invalid rating unit %q, must be one of: series, game", by)
+	}
+
+	if len(cfg.Seeds) > 0 {
+		opts.Seeds = make(map[domain.Region]float64, len(cfg.Seeds))
+		for region, seed := range cfg.Seeds {
+			opts.Seeds[domain.Region(strings.ToUpper(region))] = seed
+		}
+	}
+
+	if err := opts.Validate(); err != nil {
+		return ratings.Options{}, err
+	}
+	return opts, nil
+}
+
+// replay fetches the matches of the selected circuits and rates all teams
+func (r *RatingFlags) replay(ctx *Context, fetch *FetchFlags, now func() time.Time) ([]ratings.Rating, ratings.Options, error) {
+	opts, err := r.options(ctx.settings().Ratings)
+	if err != nil {
+		return nil, ratings.Options{}, err
+	}
+
+	circuits, err := parseCircuits(r.Circuit, now)
+	if err != nil {
+		return nil, ratings.Options{}, err
+	}
+
+	tournaments, err := fetch.fetchCircuits(ctx, circuits, nil)
+	if err != nil {
+		return nil, ratings.Options{}, err
+	}
+
+	return ratings.Replay(tournaments, opts), opts, nil
+}
+
+// RatingsListCmd lists the ratings of all teams
+type RatingsListCmd struct {
+	Region string        `help:"Show only teams that played regional tournaments of this region (NA, EU, APAC, SAM, OCE, MENA, SSA)"`
+	Limit  int           `help:"Maximum number of teams to return"`
+	Output output.Format `help:"Output format (table, json, csv, yaml)" default:"table" short:"o"`
+
+	RatingFlags `embed:""`
+	FetchFlags  `embed:""`
+
+	// now is a function that returns the current time, can be overridden for testing
+	now func() time.Time `kong:"-"`
+}
+
+func (l *RatingsListCmd) Run(ctx *Context) error {
+	if l.Limit < 0 {
+		return fmt.Errorf("limit cannot be negative")
+	}
+	if l.now == nil {
+		l.now = time.Now
+	}
+
+	records, _, err := l.replay(ctx, &l.FetchFlags, l.now)
+	if err != nil {
+		return err
+	}
+
+	if l.Region != "" {
+		filtered := make([]ratings.Rating, 0, len(records))
+		for _, r := range records {
+			if r.HasRegion(l.Region) {
+				filtered = append(filtered, r)
+			}
+		}
+		records = filtered
+		ratings.Rank(records)
+	}
+
+	if l.Limit > 0 && len(records) > l.Limit {
+		records = records[:l.Limit]
+	}
+
+	formatter, err := output.GetRatingsFormatter(l.Output)
+	if err != nil {
+		return fmt.Errorf("failed to get formatter: %w", err)
+	}
+
+	if err := formatter.Format(os.Stdout, records); err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+
+	return nil
+}
+
+// RatingsHistoryCmd shows how the rating of a team changed series by series
+type RatingsHistoryCmd struct {
+	Team   string        `arg:"" help:"Team UUID, name or shorthand"`
+	Output output.Format `help:"Output format (table, json, csv, yaml)" default:"table" short:"o"`
+
+	RatingFlags `embed:""`
+	FetchFlags  `embed:""`
+
+	// now is a function that returns the current time, can be overridden for testing
+	now func() time.Time `kong:"-"`
+}
+
+func (h *RatingsHistoryCmd) Run(ctx *Context) error {
+	if h.now == nil {
+		h.now = time.Now
+	}
+
+	records, _, err := h.replay(ctx, &h.FetchFlags, h.now)
+	if err != nil {
+		return err
+	}
+
+	rating, err := findRating(records, h.Team)
+	if err != nil {
+		return err
+	}
+
+	formatter, err := output.GetRatingHistoryFormatter(h.Output)
+	if err != nil {
+		return fmt.Errorf("failed to get formatter: %w", err)
+	}
+
+	if err := formatter.Format(os.Stdout, rating); err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+
+	return nil
+}
+
+// RatingsExportCmd exports the ratings of all teams together with the parameters they were computed with
+type RatingsExportCmd struct {
+	Output output.Format `help:"Output format (json, csv, yaml)" default:"json" short:"o"`
+
+	RatingFlags `embed:""`
+	FetchFlags  `embed:""`
+
+	// now is a function that returns the current time, can be overridden for testing
+	now func() time.Time `kong:"-"`
+}
+
+func (e *RatingsExportCmd) Run(ctx *Context) error {
+	if e.now == nil {
+		e.now = time.Now
+	}
+
+	formatter, err := output.GetRatingsExportFormatter(e.Output)
+	if err != nil {
+		return fmt.Errorf("failed to get formatter: %w", err)
+	}
+
+	records, opts, err := e.replay(ctx, &e.FetchFlags, e.now)
+	if err != nil {
+		return err
+	}
+
+	if err := formatter.Format(os.Stdout, ratings.NewExport(records, opts)); err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+
+	return nil
+}
+
+// findRating resolves a team query to exactly one rating
+func findRating(records []ratings.Rating, query string) (ratings.Rating, error) {
+	found := ratings.Find(records, query)
+	switch len(found) {
+	case 0:
+		return ratings.Rating{}, fmt.Errorf("team not found: %s", query)
+	case 1:
+		return found[0], nil
+	}
+
+	candidates := make([]string, 0, len(found))
+	for _, r := range found {
+		candidates = append(candidates, fmt.Sprintf("%s (%s)", r.Team, r.TeamUUID))
+	}
+	return ratings.Rating{}, fmt.Errorf("team %q is ambiguous, matches: %s", query, strings.Join(candidates, ", "))
+}
+
+// firstNonEmpty returns the first value that is not empty
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
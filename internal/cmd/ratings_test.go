@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/config"
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/ratings"
+	"github.com/mgranderath/rlcs-cli/internal/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRatingFlags_options(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		opts, err := (&RatingFlags{}).options(config.Ratings{})
+		require.NoError(t, err)
+		assert.Equal(t, ratings.DefaultOptions(), opts)
+	})
+
+	t.Run("config", func(t *testing.T) {
+		cfg := config.Ratings{System: "Glicko2", KFactor: 20, By: "game", Initial: 1400, Seeds: map[string]float64{"eu": 1600}}
+		opts, err := (&RatingFlags{}).options(cfg)
+		require.NoError(t, err)
+		assert.Equal(t, ratings.Options{
+			System:  ratings.SystemGlicko2,
+			KFactor: 20,
+			ByGame:  true,
+			Initial: 1400,
+			Seeds:   map[domain.Region]float64{domain.RegionEU: 1600},
+		}, opts)
+	})
+
+	t.Run("flags win over config", func(t *testing.T) {
+		cfg := config.Ratings{System: "glicko2", KFactor: 20, By: "game"}
+		opts, err := (&RatingFlags{System: "elo", KFactor: 40, By: "series"}).options(cfg)
+		require.NoError(t, err)
+		assert.Equal(t, ratings.SystemElo, opts.System)
+		assert.Equal(t, 40.0, opts.KFactor)
+		assert.False(t, opts.ByGame)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := (&RatingFlags{}).options(config.Ratings{By: "map"})
+		assert.EqualError(t, err, `invalid rating unit "map", must be one of: series, game`)
+
+		_, err = (&RatingFlags{}).options(config.Ratings{System: "trueskill"})
+		assert.EqualError(t, err, `unknown rating system "trueskill", must be one of: elo, glicko2`)
+	})
+}
+
+func TestRatingsCmds_Run_Snapshot(t *testing.T) {
+	src, err := source.NewSnapshotSource("testdata/snapshot")
+	require.NoError(t, err)
+
+	ctx := &Context{Source: src}
+	now := func() time.Time { return time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC) }
+
+	for _, format := range []output.Format{output.FormatTable, output.FormatJSON, output.FormatCSV, output.FormatYAML} {
+		list := &RatingsListCmd{Output: format, RatingFlags: RatingFlags{System: "glicko2"}, now: now}
+		require.NoError(t, list.Run(ctx))
+
+		history := &RatingsHistoryCmd{Team: "vitality", Output: format, now: now}
+		require.NoError(t, history.Run(ctx))
+	}
+
+	for _, format := range []output.Format{output.FormatJSON, output.FormatCSV, output.FormatYAML} {
+		export := &RatingsExportCmd{Output: format, RatingFlags: RatingFlags{Circuit: "2026", By: "game"}, now: now}
+		require.NoError(t, export.Run(ctx))
+	}
+
+	list := &RatingsListCmd{Limit: -1, now: now}
+	assert.EqualError(t, list.Run(ctx), "limit cannot be negative")
+
+	history := &RatingsHistoryCmd{Team: "missing", Output: output.FormatTable, now: now}
+	assert.EqualError(t, history.Run(ctx), "team not found: missing")
+
+	export := &RatingsExportCmd{Output: output.FormatTable, now: now}
+	assert.EqualError(t, export.Run(ctx), "failed to get formatter: no formatter registered for format: table")
+}
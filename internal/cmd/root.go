@@ -1,16 +1,142 @@
 package cmd
 
-import "github.com/alecthomas/kong"
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/alecthomas/kong"
+	"github.com/mgranderath/rlcs-cli/internal/api/blast"
+	"github.com/mgranderath/rlcs-cli/internal/config"
+	"github.com/mgranderath/rlcs-cli/internal/graph"
+	"github.com/mgranderath/rlcs-cli/internal/mapper"
+	"github.com/mgranderath/rlcs-cli/internal/source"
+)
 
 type Context struct {
 	Debug bool
+
+	// Strict fails commands on API records that cannot be mapped instead of skipping them
+	Strict bool
+
+	// Client is the Blast API client shared by all commands.
+	// A default client is created on first use if it is nil.
+	Client *blast.Client
+
+	// Source provides the data of all commands.
+	// The Blast API is used through Client if it is nil.
+	Source source.Source
+
+	// Config holds the settings of the configuration file, an empty configuration is used if it is nil
+	Config *config.Config
+
+	// Location is the time zone times are shown in, the local zone is used if it is nil
+	Location *time.Location
+
+	// ctx is cancelled when the user interrupts the CLI
+	ctx context.Context
+	// stderr receives warnings, defaults to os.Stderr
+	stderr io.Writer
+}
+
+// requestContext returns the context for API requests
+func (c *Context) requestContext() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
+// blastClient returns the shared Blast API client
+func (c *Context) blastClient() *blast.Client {
+	if c.Client == nil {
+		c.Client = blast.NewClient()
+	}
+	return c.Client
+}
+
+// dataSource returns the source commands read tournament data from
+func (c *Context) dataSource() source.Source {
+	if c.Source == nil {
+		c.Source = source.NewBlastSource(c.blastClient())
+	}
+	return c.Source
+}
+
+// settings returns the configuration of the CLI
+func (c *Context) settings() *config.Config {
+	if c.Config == nil {
+		c.Config = &config.Config{}
+	}
+	return c.Config
+}
+
+// location returns the time zone times are shown in
+func (c *Context) location() *time.Location {
+	if c.Location == nil {
+		return time.Local
+	}
+	return c.Location
+}
+
+// warnings returns the writer warnings are printed to
+func (c *Context) warnings() io.Writer {
+	if c.stderr == nil {
+		return os.Stderr
+	}
+	return c.stderr
+}
+
+// checkSkipped handles API records that were skipped during mapping.
+// In strict mode the first one is returned as an error, otherwise a warning is printed for each.
+func (c *Context) checkSkipped(skipped []*mapper.RecordError) error {
+	if len(skipped) == 0 {
+		return nil
+	}
+	if c.Strict {
+		return skipped[0]
+	}
+
+	w := c.warnings()
+	for _, err := range skipped {
+		fmt.Fprintf(w, "Warning: skipped %s %s: %v\n", err.Kind, err.ID, err.Err)
+	}
+	return nil
+}
+
+// checkGraph handles problems of the bracket graph of a tournament.
+// In strict mode the first one is returned as an error, otherwise a warning is printed for each.
+func (c *Context) checkGraph(issues []graph.Issue) error {
+	if len(issues) == 0 {
+		return nil
+	}
+	if c.Strict {
+		return fmt.Errorf("invalid bracket graph: %s", issues[0])
+	}
+
+	w := c.warnings()
+	for _, issue := range issues {
+		fmt.Fprintf(w, "Warning: bracket graph: %s\n", issue)
+	}
+	return nil
 }
 
 // TournamentsCmd groups all tournament-related commands
 type TournamentsCmd struct {
-	List     ListTournamentsCmd     `cmd:"" name:"list" help:"List all tournaments."`
-	Matches  TournamentsMatchesCmd  `cmd:"" name:"matches" help:"List matches across tournaments."`
-	Brackets TournamentsBracketsCmd `cmd:"" name:"brackets" help:"Get brackets for a specific tournament."`
+	List      ListTournamentsCmd      `cmd:"" name:"list" help:"List all tournaments."`
+	Matches   TournamentsMatchesCmd   `cmd:"" name:"matches" help:"List matches across tournaments."`
+	Brackets  TournamentsBracketsCmd  `cmd:"" name:"brackets" help:"Get brackets for a specific tournament."`
+	Standings TournamentsStandingsCmd `cmd:"" name:"standings" help:"Compute standings of the Swiss and round-robin brackets of a tournament."`
+	Results   TournamentsResultsCmd   `cmd:"" name:"results" help:"Derive the final placements of a tournament from its brackets."`
+	Simulate  TournamentsSimulateCmd  `cmd:"" name:"simulate" help:"Estimate the chances of every team of a tournament with Monte Carlo simulations."`
+	Path      TournamentsPathCmd      `cmd:"" name:"path" help:"Show the series a team still has to win to win a tournament."`
+	Alive     TournamentsAliveCmd     `cmd:"" name:"alive" help:"List the teams of a tournament that are not eliminated yet with their next series."`
 }
 
 // MatchesCmd groups all match-related commands
@@ -19,18 +145,224 @@ type MatchesCmd struct {
 	Get  MatchesGetCmd  `cmd:"" name:"get" help:"Get detailed information for a specific match."`
 }
 
+// TeamsCmd groups all team-related commands
+type TeamsCmd struct {
+	List TeamsListCmd `cmd:"" name:"list" help:"List all teams that played in a circuit."`
+	Get  TeamsGetCmd  `cmd:"" name:"get" help:"Get the record of a specific team."`
+}
+
+// CircuitCmd groups all commands working across the tournaments of a circuit
+type CircuitCmd struct {
+	Standings CircuitStandingsCmd `cmd:"" name:"standings" help:"Compute the circuit points leaderboard of a season."`
+}
+
+// RatingsCmd groups all team rating commands
+type RatingsCmd struct {
+	List    RatingsListCmd    `cmd:"" name:"list" help:"List the ratings of all teams."`
+	History RatingsHistoryCmd `cmd:"" name:"history" help:"Show how the rating of a team changed series by series."`
+	Export  RatingsExportCmd  `cmd:"" name:"export" help:"Export the ratings of all teams with the parameters they were computed with."`
+}
+
+// ScheduleCmd groups all calendar commands
+type ScheduleCmd struct {
+	Export ScheduleExportCmd `cmd:"" name:"export" help:"Export upcoming series as calendar events."`
+}
+
+// NotifyCmd groups the match event notification commands
+type NotifyCmd struct {
+	Run  NotifyRunCmd  `cmd:"" name:"run" help:"Poll the matches of a circuit and post lifecycle events to webhooks."`
+	Test NotifyTestCmd `cmd:"" name:"test" help:"Post a sample event to every webhook."`
+}
+
+// APICmd groups commands inspecting the Blast API itself
+type APICmd struct {
+	Doctor APIDoctorCmd `cmd:"" name:"doctor" help:"Check sample API responses for schema drift."`
+}
+
+// DevCmd groups commands for developing against the CLI
+type DevCmd struct {
+	MockServer DevMockServerCmd `cmd:"" name:"mock-server" help:"Serve the Blast API endpoints from fixture files."`
+}
+
 var cli struct {
-	Debug   bool             `help:"Enable debug mode."`
-	Version kong.VersionFlag `name:"version" short:"v" help:"Show version and exit."`
+	Debug     bool   `help:"Enable debug mode (traces API requests, mapping and output)."`
+	LogFormat string `name:"log-format" help:"Log format (text, json)." enum:"text,json" default:"text"`
+	LogFile   string `name:"log-file" help:"Write logs to this file instead of stderr." type:"path"`
+
+	Version        kong.VersionFlag `name:"version" short:"v" help:"Show version and exit."`
+	Config         string           `help:"Configuration file (defaults to config.yaml in the user config dir)." type:"path" env:"RLCS_CONFIG"`
+	Source         string           `help:"Data source: 'blast' for the live API or a directory of JSON snapshots (default from config, else blast)." env:"RLCS_SOURCE"`
+	APIURL         string           `name:"api-url" help:"Base URL of the Blast API." default:"${api_url}" env:"RLCS_API_URL"`
+	Timeout        time.Duration    `help:"Timeout for a single API request." default:"10s"`
+	Retries        int              `help:"Number of retries for transient API failures (429, 5xx, timeouts)." default:"3"`
+	StrictDecoding bool             `name:"strict-decoding" help:"Fail on API response fields the CLI does not know about."`
+	Strict         bool             `help:"Fail on API records that cannot be mapped instead of skipping them with a warning."`
+	TZ             string           `name:"tz" help:"Time zone times are shown in, e.g. Europe/Paris, America/New_York or UTC (default from config, else the local zone)." env:"RLCS_TZ"`
+
+	NoCache  bool   `name:"no-cache" help:"Disable the on-disk response cache."`
+	Refresh  bool   `help:"Revalidate all cached responses with the API."`
+	CacheDir string `name:"cache-dir" help:"Directory for cached responses (defaults to the user cache dir)." env:"RLCS_CACHE_DIR"`
+
+	Record string `help:"Record all API traffic to this directory." type:"path" xor:"traffic"`
+	Replay string `help:"Serve API responses from a recording directory instead of the network." type:"existingdir" xor:"traffic"`
 
 	Tournaments TournamentsCmd `cmd:"" name:"tournaments" help:"Tournament-related commands."`
 	Matches     MatchesCmd     `cmd:"" name:"matches" help:"Match-related commands."`
+	Teams       TeamsCmd       `cmd:"" name:"teams" help:"Team-related commands."`
+	H2H         H2HCmd         `cmd:"" name:"h2h" help:"Head-to-head history between two teams."`
+	Circuit     CircuitCmd     `cmd:"" name:"circuit" help:"Circuit-wide commands."`
+	Ratings     RatingsCmd     `cmd:"" name:"ratings" help:"Team ratings computed from historical results."`
+	Stats       StatsCmd       `cmd:"" name:"stats" help:"Series and game statistics of a tournament, a circuit or a team."`
+	Schedule    ScheduleCmd    `cmd:"" name:"schedule" help:"Match schedule commands."`
+	Today       TodayCmd       `cmd:"" name:"today" help:"Live, upcoming and finished series of followed teams today."`
+	Week        WeekCmd        `cmd:"" name:"week" help:"Live, upcoming and finished series of followed teams in the next seven days."`
+	Notify      NotifyCmd      `cmd:"" name:"notify" help:"Post match events to webhooks."`
+	API         APICmd         `cmd:"" name:"api" help:"Blast API diagnostics."`
+	Dev         DevCmd         `cmd:"" name:"dev" help:"Development tools."`
 }
 
 func Execute(version string) {
 	ctx := kong.Parse(&cli, kong.Vars{
 		"version": version,
+		"api_url": blast.BaseURL,
 	})
-	err := ctx.Run(&Context{Debug: cli.Debug})
+
+	logOutput, closeLog, err := openLogOutput(cli.LogFile)
+	ctx.FatalIfErrorf(err)
+
+	logger := newLogger(logOutput, cli.LogFormat, cli.Debug)
+	slog.SetDefault(logger)
+
+	cfg, err := loadConfig()
+	ctx.FatalIfErrorf(err)
+
+	transport, err := apiTransport(cfg.Cache)
+	ctx.FatalIfErrorf(err)
+
+	client := blast.NewClient(
+		blast.WithBaseURL(cli.APIURL),
+		blast.WithTransport(transport),
+		blast.WithTimeout(cli.Timeout),
+		blast.WithRetry(cli.Retries, blast.DefaultRetryBaseDelay),
+		blast.WithUserAgent("rlcs-cli/"+version),
+		blast.WithLogger(logger),
+		blast.WithStrictDecoding(cli.StrictDecoding),
+	)
+
+	sourceName := cli.Source
+	if sourceName == "" {
+		sourceName = cfg.Source
+	}
+	src, err := source.Open(sourceName, client)
+	ctx.FatalIfErrorf(err)
+
+	tzName := cli.TZ
+	if tzName == "" {
+		tzName = cfg.Timezone
+	}
+	loc, err := loadLocation(tzName)
 	ctx.FatalIfErrorf(err)
+
+	interruptCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	err = ctx.Run(&Context{
+		Debug:    cli.Debug,
+		Strict:   cli.Strict,
+		Client:   client,
+		Source:   source.InLocation(src, loc),
+		Config:   cfg,
+		Location: loc,
+		ctx:      interruptCtx,
+	})
+	stop()
+	closeLog()
+	ctx.FatalIfErrorf(err)
+}
+
+// loadConfig reads the configuration file given by --config, which must exist, or the optional default one
+func loadConfig() (*config.Config, error) {
+	if cli.Config != "" {
+		return config.Load(cli.Config)
+	}
+	return config.LoadDefault()
+}
+
+// loadLocation returns the time zone with the given IANA name, the local zone if name is empty or "local"
+func loadLocation(name string) (*time.Location, error) {
+	if name == "" || strings.EqualFold(name, "local") {
+		return time.Local, nil
+	}
+	if strings.EqualFold(name, "utc") {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone %q: %w", name, err)
+	}
+	return loc, nil
+}
+
+// apiTransport returns the transport for API requests based on the global flags.
+// Replays never touch the network or cache; recordings capture what the client receives.
+func apiTransport(cache config.Cache) (http.RoundTripper, error) {
+	if cli.Replay != "" {
+		return blast.NewReplayTransport(cli.Replay)
+	}
+
+	transport, err := cacheTransport(cache)
+	if err != nil {
+		return nil, err
+	}
+	if cli.Record != "" {
+		return blast.NewRecordTransport(cli.Record, transport)
+	}
+	return transport, nil
+}
+
+// cacheTransport returns the transport for API requests based on the cache flags and settings.
+// The cache is skipped if it is disabled or no cache directory can be determined.
+func cacheTransport(cache config.Cache) (http.RoundTripper, error) {
+	if cli.NoCache {
+		return nil, nil
+	}
+
+	ttls, err := cacheTTLs(cache.TTL)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := cli.CacheDir
+	if dir == "" {
+		defaultDir, err := blast.DefaultCacheDir()
+		if err != nil {
+			return nil, nil
+		}
+		dir = defaultDir
+	}
+
+	transport := blast.NewCacheTransport(dir, nil)
+	transport.TTLs = ttls
+	transport.Refresh = cli.Refresh
+	return transport, nil
+}
+
+// cacheTTLs returns the default cache TTLs overridden by the configured ones
+func cacheTTLs(cfg config.CacheTTL) (blast.CacheTTLs, error) {
+	ttls := blast.DefaultCacheTTLs
+	for _, ttl := range []struct {
+		name  string
+		value time.Duration
+		dest  *time.Duration
+	}{
+		{"circuits", cfg.Circuits, &ttls.Circuits},
+		{"finished", cfg.Finished, &ttls.Finished},
+		{"live", cfg.Live, &ttls.Live},
+	} {
+		if ttl.value < 0 {
+			return blast.CacheTTLs{}, fmt.Errorf("cache ttl %s cannot be negative", ttl.name)
+		}
+		if ttl.value > 0 {
+			*ttl.dest = ttl.value
+		}
+	}
+	return ttls, nil
 }
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/api/blast"
+	"github.com/mgranderath/rlcs-cli/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadLocation(t *testing.T) {
+	loc, err := loadLocation("")
+	require.NoError(t, err)
+	assert.Equal(t, time.Local, loc)
+
+	loc, err = loadLocation("Local")
+	require.NoError(t, err)
+	assert.Equal(t, time.Local, loc)
+
+	loc, err = loadLocation("utc")
+	require.NoError(t, err)
+	assert.Equal(t, time.UTC, loc)
+
+	loc, err = loadLocation("America/New_York")
+	require.NoError(t, err)
+	assert.Equal(t, "America/New_York", loc.String())
+
+	_, err = loadLocation("Mars/Olympus_Mons")
+	assert.ErrorContains(t, err, `invalid time zone "Mars/Olympus_Mons"`)
+}
+
+func TestCacheTTLs(t *testing.T) {
+	ttls, err := cacheTTLs(config.CacheTTL{})
+	require.NoError(t, err)
+	assert.Equal(t, blast.DefaultCacheTTLs, ttls)
+
+	ttls, err = cacheTTLs(config.CacheTTL{Finished: 24 * time.Hour, Live: 5 * time.Second})
+	require.NoError(t, err)
+	assert.Equal(t, blast.CacheTTLs{Circuits: blast.DefaultCacheTTLs.Circuits, Finished: 24 * time.Hour, Live: 5 * time.Second}, ttls)
+
+	_, err = cacheTTLs(config.CacheTTL{Live: -time.Second})
+	assert.EqualError(t, err, "cache ttl live cannot be negative")
+}
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/api/blast"
+	"github.com/mgranderath/rlcs-cli/internal/calendar"
+	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/teams"
+)
+
+// ScheduleExportCmd exports upcoming series as calendar events
+type ScheduleExportCmd struct {
+	Circuit     string                `help:"Circuit/year to fetch tournaments from (e.g., 2025, 2026)" default:""`
+	Region      string                `help:"Filter by region (NA, EU, APAC, SAM, OCE, MENA, SSA)"`
+	Online      bool                  `help:"Show only online tournaments"`
+	Major       bool                  `help:"Show only major tournaments (empty region/grouping)"`
+	Grouping    string                `help:"Filter by tournament grouping (e.g., 'RLCS Open 1 2026')"`
+	MinTeams    int                   `help:"Minimum number of teams"`
+	Team        string                `help:"Export only the series of a team (UUID, name or shorthand)"`
+	Completed   bool                  `help:"Also export completed series"`
+	Tournaments bool                  `help:"Also export tournaments as all-day events"`
+	Format      output.ScheduleFormat `help:"Calendar format (ics)" default:"ics" short:"f"`
+	State       string                `help:"File remembering exported events so that rescheduled series update existing entries (defaults to schedule.json in the user cache dir)" type:"path"`
+
+	FetchFlags `embed:""`
+
+	// now is a function that returns the current time, can be overridden for testing
+	now func() time.Time `kong:"-"`
+}
+
+func (s *ScheduleExportCmd) Run(ctx *Context) error {
+	if s.MinTeams < 0 {
+		return fmt.Errorf("min-teams cannot be negative")
+	}
+	if s.now == nil {
+		s.now = time.Now
+	}
+	circuit := s.Circuit
+	if circuit == "" {
+		circuit = fmt.Sprintf("%d", s.now().Year())
+	}
+
+	formatter, err := output.GetScheduleFormatter(s.Format)
+	if err != nil {
+		return fmt.Errorf("failed to get formatter: %w", err)
+	}
+
+	statePath := s.State
+	if statePath == "" {
+		dir, err := blast.DefaultCacheDir()
+		if err != nil {
+			return err
+		}
+		statePath = filepath.Join(dir, "schedule.json")
+	}
+	state, err := calendar.LoadState(statePath)
+	if err != nil {
+		return err
+	}
+
+	filters := &TournamentsMatchesCmd{Region: s.Region, Online: s.Online, Major: s.Major, Grouping: s.Grouping, MinTeams: s.MinTeams}
+	tournaments, err := s.fetchCircuit(ctx, circuit, filters.matchesTournamentFilters)
+	if err != nil {
+		return err
+	}
+
+	today := s.now().UTC().Truncate(24 * time.Hour)
+	var series, events []calendar.Event
+	for _, t := range tournaments {
+		matched := false
+		for _, match := range t.Matches {
+			if match.UUID == "" || match.TimeOfSeries.IsZero() || (match.IsOver() && !s.Completed) {
+				continue
+			}
+			if s.Team != "" && !teams.IsParticipant(match.TeamA, s.Team) && !teams.IsParticipant(match.TeamB, s.Team) {
+				continue
+			}
+			matched = true
+			series = append(series, calendar.SeriesEvent(t.Tournament, match))
+		}
+
+		// Without a team every tournament that is not over yet is exported, with a team only the tournaments it plays in
+		if !s.Tournaments || t.Tournament.StartDate.IsZero() {
+			continue
+		}
+		if s.Team != "" && !matched {
+			continue
+		}
+		if s.Team == "" && !s.Completed && t.Tournament.EndDate.Before(today) {
+			continue
+		}
+		events = append(events, calendar.TournamentEvent(t.Tournament))
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Start.Before(events[j].Start)
+	})
+	sort.SliceStable(series, func(i, j int) bool {
+		return series[i].Start.Before(series[j].Start)
+	})
+	events = append(events, series...)
+
+	now := s.now()
+	state.Apply(events, now)
+
+	if err := formatter.Format(os.Stdout, events, now); err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+
+	return state.Save(statePath)
+}
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/calendar"
+	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleExportCmd_Run_Snapshot(t *testing.T) {
+	src, err := source.NewSnapshotSource("testdata/snapshot")
+	require.NoError(t, err)
+
+	ctx := &Context{Source: src}
+	now := func() time.Time { return time.Date(2026, 3, 4, 18, 0, 0, 0, time.UTC) }
+	statePath := filepath.Join(t.TempDir(), "schedule.json")
+
+	cmd := &ScheduleExportCmd{Tournaments: true, Format: output.ScheduleFormatICS, State: statePath, now: now}
+	require.NoError(t, cmd.Run(ctx))
+
+	state, err := calendar.LoadState(statePath)
+	require.NoError(t, err)
+	assert.Contains(t, state.Events, "p-final@rlcs-cli")
+	assert.Contains(t, state.Events, "p-sf2@rlcs-cli")
+	assert.Contains(t, state.Events, "tournament-major@rlcs-cli")
+	assert.NotContains(t, state.Events, "s1@rlcs-cli")
+
+	cmd = &ScheduleExportCmd{Team: "FLCN", Completed: true, Format: output.ScheduleFormatICS, State: filepath.Join(t.TempDir(), "team.json"), now: now}
+	require.NoError(t, cmd.Run(ctx))
+	state, err = calendar.LoadState(cmd.State)
+	require.NoError(t, err)
+	assert.Len(t, state.Events, 4)
+
+	// A series between two games is still exported without --completed
+	dir := t.TempDir()
+	require.NoError(t, os.CopyFS(dir, os.DirFS("testdata/snapshot")))
+	matchesPath := filepath.Join(dir, "tournaments", "major", "matches.json")
+	data, err := os.ReadFile(matchesPath)
+	require.NoError(t, err)
+	data = bytes.Replace(data, []byte(`"startedAt": "2026-03-04T17:21:00Z"`), []byte(`"startedAt": ""`), 1)
+	require.NoError(t, os.WriteFile(matchesPath, data, 0o644))
+	paused, err := source.NewSnapshotSource(dir)
+	require.NoError(t, err)
+
+	cmd = &ScheduleExportCmd{Format: output.ScheduleFormatICS, State: filepath.Join(t.TempDir(), "paused.json"), now: now}
+	require.NoError(t, cmd.Run(&Context{Source: paused}))
+	state, err = calendar.LoadState(cmd.State)
+	require.NoError(t, err)
+	assert.Contains(t, state.Events, "p-sf2@rlcs-cli")
+
+	cmd = &ScheduleExportCmd{Format: "html", State: statePath, now: now}
+	assert.EqualError(t, cmd.Run(ctx), "failed to get formatter: no formatter registered for format: html")
+
+	cmd = &ScheduleExportCmd{MinTeams: -1, Format: output.ScheduleFormatICS, State: statePath, now: now}
+	assert.EqualError(t, cmd.Run(ctx), "min-teams cannot be negative")
+}
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/api/blast"
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/stats"
+)
+
+// StatsCmd shows series and game statistics of a tournament, a circuit or a team
+type StatsCmd struct {
+	Circuit       string        `help:"Circuit/year or range of circuits (e.g., 2026, 2024..2026)" default:""`
+	Tournament    string        `help:"Tournament ID (UUID) to restrict the statistics to"`
+	Team          string        `help:"Restrict the statistics to the series of a team (UUID, name or shorthand)"`
+	OvertimeAfter time.Duration `name:"overtime-after" help:"Game length from which a game decided by one goal counts as likely overtime" default:"8m"`
+	Top           int           `help:"Number of longest games and comebacks to list" default:"5"`
+	Output        output.Format `help:"Output format (table, json, csv, yaml)" default:"table" short:"o"`
+
+	FetchFlags `embed:""`
+
+	// now is a function that returns the current time, can be overridden for testing
+	now func() time.Time `kong:"-"`
+}
+
+func (s *StatsCmd) Run(ctx *Context) error {
+	if s.Top < 0 {
+		return fmt.Errorf("top cannot be negative")
+	}
+	if s.OvertimeAfter <= 0 {
+		return fmt.Errorf("overtime-after must be positive")
+	}
+	if s.Tournament != "" && s.Circuit != "" {
+		return fmt.Errorf("--tournament and --circuit cannot be used together")
+	}
+	if s.now == nil {
+		s.now = time.Now
+	}
+
+	tournaments, err := s.fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	opts := stats.Options{Team: s.Team, OvertimeAfter: s.OvertimeAfter, Top: s.Top}
+	result := stats.Compute(tournaments, opts)
+
+	formatter, err := output.GetStatsFormatter(s.Output)
+	if err != nil {
+		return fmt.Errorf("failed to get formatter: %w", err)
+	}
+
+	if err := formatter.Format(os.Stdout, result); err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+
+	return nil
+}
+
+// fetch returns the matches of the selected tournament, or of every tournament of the selected circuits
+func (s *StatsCmd) fetch(ctx *Context) ([]domain.TournamentMatches, error) {
+	if s.Tournament == "" {
+		circuits, err := parseCircuits(s.Circuit, s.now)
+		if err != nil {
+			return nil, err
+		}
+		return s.fetchCircuits(ctx, circuits, nil)
+	}
+
+	result, err := ctx.dataSource().TournamentMatches(ctx.requestContext(), s.Tournament)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.checkSkipped(result.Skipped); err != nil {
+		return nil, fmt.Errorf("failed to map matches: %w", err)
+	}
+	tournament, err := s.findTournament(ctx, result.Items)
+	if err != nil {
+		return nil, err
+	}
+	return []domain.TournamentMatches{{Tournament: tournament, Matches: result.Items}}, nil
+}
+
+// findTournament looks up the selected tournament in the circuits its series are played in.
+// The circuit of a season can start in the year before, so the following circuit is searched too.
+func (s *StatsCmd) findTournament(ctx *Context, matches []domain.Match) (domain.Tournament, error) {
+	years := map[int]bool{s.now().Year(): true}
+	for _, match := range matches {
+		if !match.TimeOfSeries.IsZero() {
+			years[match.TimeOfSeries.Year()] = true
+			years[match.TimeOfSeries.Year()+1] = true
+		}
+	}
+	circuits := make([]string, 0, len(years))
+	for year := range years {
+		circuits = append(circuits, strconv.Itoa(year))
+	}
+	sort.Strings(circuits)
+
+	for _, circuit := range circuits {
+		tournaments, err := ctx.dataSource().Tournaments(ctx.requestContext(), circuit)
+		if errors.Is(err, blast.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return domain.Tournament{}, fmt.Errorf("circuit %s: %w", circuit, err)
+		}
+		for _, t := range tournaments.Items {
+			if t.ID == s.Tournament {
+				return t, nil
+			}
+		}
+	}
+	return domain.Tournament{}, fmt.Errorf("tournament %s not found in circuits %s", s.Tournament, strings.Join(circuits, ", "))
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsCmd_Run_Snapshot(t *testing.T) {
+	src, err := source.NewSnapshotSource("testdata/snapshot")
+	require.NoError(t, err)
+
+	ctx := &Context{Source: src}
+	now := func() time.Time { return time.Date(2026, 3, 4, 18, 0, 0, 0, time.UTC) }
+
+	for _, format := range []output.Format{output.FormatTable, output.FormatJSON, output.FormatCSV, output.FormatYAML} {
+		cmd := &StatsCmd{OvertimeAfter: 8 * time.Minute, Top: 5, Output: format, now: now}
+		require.NoError(t, cmd.Run(ctx))
+	}
+
+	cmd := &StatsCmd{Tournament: "major", Team: "VIT", OvertimeAfter: 8 * time.Minute, Top: 5, Output: output.FormatTable, now: now}
+	require.NoError(t, cmd.Run(ctx))
+
+	// The tournament is looked up in its circuit, so its name shows in the output
+	result, err := src.TournamentMatches(context.Background(), "major")
+	require.NoError(t, err)
+	tournament, err := cmd.findTournament(ctx, result.Items)
+	require.NoError(t, err)
+	assert.Equal(t, "RLCS 2026 Major 1", tournament.Name)
+
+	cmd.Tournament = "unlisted"
+	_, err = cmd.findTournament(ctx, result.Items)
+	assert.EqualError(t, err, "tournament unlisted not found in circuits 2026, 2027")
+
+	cmd = &StatsCmd{Tournament: "missing", OvertimeAfter: 8 * time.Minute, Output: output.FormatTable, now: now}
+	assert.EqualError(t, cmd.Run(ctx), "tournament not found: missing")
+
+	cmd = &StatsCmd{Tournament: "major", Circuit: "2026", OvertimeAfter: 8 * time.Minute, now: now}
+	assert.EqualError(t, cmd.Run(ctx), "--tournament and --circuit cannot be used together")
+
+	cmd = &StatsCmd{Top: -1, OvertimeAfter: 8 * time.Minute, now: now}
+	assert.EqualError(t, cmd.Run(ctx), "top cannot be negative")
+
+	cmd = &StatsCmd{now: now}
+	assert.EqualError(t, cmd.Run(ctx), "overtime-after must be positive")
+}
@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/teams"
+)
+
+// TeamsListCmd lists all teams that played in a circuit
+type TeamsListCmd struct {
+	Circuit string             `help:"Circuit/year to aggregate teams from (e.g., 2025, 2026)" default:""`
+	Region  string             `help:"Show only teams that played regional tournaments of this region (NA, EU, APAC, SAM, OCE, MENA, SSA)"`
+	Limit   int                `help:"Maximum number of teams to return"`
+	Output  output.TeamsFormat `help:"Output format (table, json, yaml)" default:"table" short:"o"`
+
+	FetchFlags `embed:""`
+
+	// now is a function that returns the current time, can be overridden for testing
+	now func() time.Time `kong:"-"`
+}
+
+func (l *TeamsListCmd) Run(ctx *Context) error {
+	if l.Limit < 0 {
+		return fmt.Errorf("limit cannot be negative")
+	}
+	if l.now == nil {
+		l.now = time.Now
+	}
+
+	records, err := fetchTeams(ctx, &l.FetchFlags, circuitOrCurrent(l.Circuit, l.now), teams.DefaultRecent)
+	if err != nil {
+		return err
+	}
+
+	if l.Region != "" {
+		filtered := make([]teams.Team, 0, len(records))
+		for _, team := range records {
+			if team.HasRegion(l.Region) {
+				filtered = append(filtered, team)
+			}
+		}
+		records = filtered
+	}
+
+	if l.Limit > 0 && len(records) > l.Limit {
+		records = records[:l.Limit]
+	}
+
+	formatter, err := output.GetTeamsFormatter(l.Output)
+	if err != nil {
+		return fmt.Errorf("failed to get formatter: %w", err)
+	}
+
+	if err := formatter.Format(os.Stdout, records); err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+
+	return nil
+}
+
+// TeamsGetCmd shows the record of a single team in a circuit
+type TeamsGetCmd struct {
+	Team    string             `arg:"" help:"Team UUID, name or shorthand"`
+	Circuit string             `help:"Circuit/year to aggregate the team from (e.g., 2025, 2026)" default:""`
+	Recent  int                `help:"Number of recent results to show" default:"5"`
+	Output  output.TeamsFormat `help:"Output format (table, json, yaml)" default:"table" short:"o"`
+
+	FetchFlags `embed:""`
+
+	// now is a function that returns the current time, can be overridden for testing
+	now func() time.Time `kong:"-"`
+}
+
+func (g *TeamsGetCmd) Run(ctx *Context) error {
+	if g.Recent < 0 {
+		return fmt.Errorf("recent cannot be negative")
+	}
+	if g.now == nil {
+		g.now = time.Now
+	}
+
+	records, err := fetchTeams(ctx, &g.FetchFlags, circuitOrCurrent(g.Circuit, g.now), g.Recent)
+	if err != nil {
+		return err
+	}
+
+	team, err := findTeam(records, g.Team)
+	if err != nil {
+		return err
+	}
+
+	formatter, err := output.GetTeamFormatter(g.Output)
+	if err != nil {
+		return fmt.Errorf("failed to get formatter: %w", err)
+	}
+
+	if err := formatter.Format(os.Stdout, team); err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+
+	return nil
+}
+
+// fetchTeams aggregates the teams of all tournaments in a circuit
+func fetchTeams(ctx *Context, fetch *FetchFlags, circuit string, recent int) ([]teams.Team, error) {
+	tournaments, err := fetch.fetchCircuit(ctx, circuit, nil)
+	if err != nil {
+		return nil, err
+	}
+	return teams.Aggregate(tournaments, recent), nil
+}
+
+// findTeam resolves a team query to exactly one team
+func findTeam(records []teams.Team, query string) (teams.Team, error) {
+	found := teams.Find(records, query)
+	switch len(found) {
+	case 0:
+		return teams.Team{}, fmt.Errorf("team not found: %s", query)
+	case 1:
+		return found[0], nil
+	}
+
+	candidates := make([]string, 0, len(found))
+	for _, team := range found {
+		candidates = append(candidates, fmt.Sprintf("%s (%s)", team.Name, team.UUID))
+	}
+	return teams.Team{}, fmt.Errorf("team %q is ambiguous, matches: %s", query, strings.Join(candidates, ", "))
+}
+
+// circuitOrCurrent returns circuit or the current year if it is empty
+func circuitOrCurrent(circuit string, now func() time.Time) string {
+	if circuit == "" {
+		return fmt.Sprintf("%d", now().Year())
+	}
+	return circuit
+}
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/source"
+	"github.com/mgranderath/rlcs-cli/internal/teams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamsListCmd_Run_Snapshot(t *testing.T) {
+	src, err := source.NewSnapshotSource("../source/testdata/snapshot")
+	require.NoError(t, err)
+
+	cmd := &TeamsListCmd{Circuit: "2025", Region: "EU", Output: output.TeamsFormatJSON}
+
+	var stderr bytes.Buffer
+	err = cmd.Run(&Context{Source: src, stderr: &stderr})
+	require.NoError(t, err)
+	assert.Contains(t, stderr.String(), "Warning: skipped match m2")
+
+	cmd.Limit = -1
+	err = cmd.Run(&Context{Source: src, stderr: &stderr})
+	assert.EqualError(t, err, "limit cannot be negative")
+}
+
+func TestTeamsGetCmd_Run_Snapshot(t *testing.T) {
+	src, err := source.NewSnapshotSource("../source/testdata/snapshot")
+	require.NoError(t, err)
+
+	var stderr bytes.Buffer
+	ctx := &Context{Source: src, stderr: &stderr}
+
+	cmd := &TeamsGetCmd{Team: "KC", Circuit: "2025", Recent: 5, Output: output.TeamsFormatJSON}
+	require.NoError(t, cmd.Run(ctx))
+
+	cmd.Team = "G2"
+	assert.EqualError(t, cmd.Run(ctx), "team not found: G2")
+}
+
+func TestFindTeam(t *testing.T) {
+	records := []teams.Team{
+		{UUID: "t1", Name: "Team Vitality", Shorthand: "VIT"},
+		{UUID: "t2", Name: "Team Falcons", Shorthand: "FLCN"},
+	}
+
+	team, err := findTeam(records, "vit")
+	require.NoError(t, err)
+	assert.Equal(t, "t1", team.UUID)
+
+	_, err = findTeam(records, "team")
+	assert.EqualError(t, err, `team "team" is ambiguous, matches: Team Vitality (t1), Team Falcons (t2)`)
+}
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/mgranderath/rlcs-cli/internal/graph"
+	"github.com/mgranderath/rlcs-cli/internal/output"
+)
+
+// TournamentsAliveCmd lists the teams of a tournament that are not eliminated yet with their next series
+type TournamentsAliveCmd struct {
+	TournamentID string        `arg:"" help:"Tournament ID (UUID)"`
+	Output       output.Format `help:"Output format (table, json, csv, yaml)" default:"table" short:"o"`
+}
+
+func (a *TournamentsAliveCmd) Run(ctx *Context) error {
+	result, err := ctx.dataSource().TournamentBrackets(ctx.requestContext(), a.TournamentID)
+	if err != nil {
+		return err
+	}
+	if err := ctx.checkSkipped(result.Skipped); err != nil {
+		return fmt.Errorf("failed to map brackets: %w", err)
+	}
+
+	g := graph.Build(result.Items)
+	if err := ctx.checkGraph(g.Validate()); err != nil {
+		return err
+	}
+
+	formatter, err := output.GetAliveFormatter(a.Output)
+	if err != nil {
+		return fmt.Errorf("failed to get formatter: %w", err)
+	}
+
+	if err := formatter.Format(os.Stdout, g.Alive()); err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTournamentsAliveCmd_Run_Snapshot(t *testing.T) {
+	src, err := source.NewSnapshotSource("testdata/snapshot")
+	require.NoError(t, err)
+
+	ctx := &Context{Source: src}
+	for _, format := range []output.Format{output.FormatTable, output.FormatJSON, output.FormatCSV, output.FormatYAML} {
+		cmd := &TournamentsAliveCmd{TournamentID: "major", Output: format}
+		require.NoError(t, cmd.Run(ctx))
+	}
+
+	cmd := &TournamentsAliveCmd{TournamentID: "missing", Output: output.FormatTable}
+	assert.EqualError(t, cmd.Run(ctx), "tournament not found: missing")
+}
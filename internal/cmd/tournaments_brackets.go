@@ -1,17 +1,11 @@
 package cmd
 
 import (
-	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 	"os"
 	"strings"
-	"time"
 
-	"github.com/mgranderath/rlcs-cli/internal/api/blast"
 	"github.com/mgranderath/rlcs-cli/internal/domain"
-	"github.com/mgranderath/rlcs-cli/internal/mapper"
 	"github.com/mgranderath/rlcs-cli/internal/output"
 )
 
@@ -72,47 +66,14 @@ func (g *TournamentsBracketsCmd) Run(ctx *Context) error {
 		return fmt.Errorf("cannot use multiple status filters together (completed-only, live-only, upcoming-only are mutually exclusive)")
 	}
 
-	url := fmt.Sprintf("%s/games/rl/tournaments/%s/brackets", blast.BaseURL, g.TournamentID)
-
-	client := &http.Client{
-		Timeout: 10 * time.Second,
-	}
-
-	req, err := http.NewRequest("GET", url, nil)
+	result, err := ctx.dataSource().TournamentBrackets(ctx.requestContext(), g.TournamentID)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Set("Accept", "application/json")
-
-	resp, err := client.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to make request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("tournament not found: %s", g.TournamentID)
+		return err
 	}
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	var apiBrackets []blast.Bracket
-	if err := json.Unmarshal(body, &apiBrackets); err != nil {
-		return fmt.Errorf("failed to parse JSON: %w", err)
-	}
-
-	// Map API response to domain model
-	brackets, err := mapper.ToDomainBrackets(apiBrackets)
-	if err != nil {
+	if err := ctx.checkSkipped(result.Skipped); err != nil {
 		return fmt.Errorf("failed to map brackets: %w", err)
 	}
+	brackets := result.Items
 
 	// Apply filters to matches within each bracket
 	brackets = g.applyFilters(brackets)
@@ -2,8 +2,10 @@ package cmd
 
 import (
 	"testing"
+	"time"
 
 	"github.com/h2non/gock"
+	"github.com/mgranderath/rlcs-cli/internal/api/blast"
 	"github.com/mgranderath/rlcs-cli/internal/domain"
 	"github.com/mgranderath/rlcs-cli/internal/output"
 	"github.com/stretchr/testify/assert"
@@ -210,13 +212,14 @@ func TestTournamentsBracketsCmd_Run_HTTPMock(t *testing.T) {
 	t.Run("500 response", func(t *testing.T) {
 		gock.New("https://api.blast.tv").
 			Get("/v2/games/rl/tournaments/test-id/brackets").
+			Times(3).
 			Reply(500)
 
 		cmd := &TournamentsBracketsCmd{
 			TournamentID: "test-id",
 			Output:       output.BracketsFormatTable,
 		}
-		ctx := &Context{Debug: false}
+		ctx := &Context{Debug: false, Client: blast.NewClient(blast.WithRetry(2, time.Millisecond))}
 
 		err := cmd.Run(ctx)
 		assert.Error(t, err)
@@ -1,17 +1,12 @@
 package cmd
 
 import (
-	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 	"os"
 	"strings"
 	"time"
 
-	"github.com/mgranderath/rlcs-cli/internal/api/blast"
 	"github.com/mgranderath/rlcs-cli/internal/domain"
-	"github.com/mgranderath/rlcs-cli/internal/mapper"
 	"github.com/mgranderath/rlcs-cli/internal/output"
 )
 
@@ -98,44 +93,14 @@ func (l *ListTournamentsCmd) Run(ctx *Context) error {
 		circuit = fmt.Sprintf("%d", l.now().Year())
 	}
 
-	url := fmt.Sprintf("%s/circuits/%s/tournaments?game=rl", blast.BaseURL, circuit)
-
-	client := &http.Client{
-		Timeout: 10 * time.Second,
-	}
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Set("Accept", "application/json")
-
-	resp, err := client.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to make request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
-
-	body, err := io.ReadAll(resp.Body)
+	result, err := ctx.dataSource().Tournaments(ctx.requestContext(), circuit)
 	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
+		return err
 	}
-
-	var apiTournaments []blast.Tournament
-	if err := json.Unmarshal(body, &apiTournaments); err != nil {
-		return fmt.Errorf("failed to parse JSON: %w", err)
-	}
-
-	// Map API response to domain model
-	tournaments, err := mapper.ToDomainTournaments(apiTournaments)
-	if err != nil {
+	if err := ctx.checkSkipped(result.Skipped); err != nil {
 		return fmt.Errorf("failed to map tournaments: %w", err)
 	}
+	tournaments := result.Items
 
 	// Apply filters
 	today := l.now().Truncate(24 * time.Hour)
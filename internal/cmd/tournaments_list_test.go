@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/h2non/gock"
+	"github.com/mgranderath/rlcs-cli/internal/api/blast"
 	"github.com/mgranderath/rlcs-cli/internal/domain"
 	"github.com/stretchr/testify/assert"
 )
@@ -248,6 +249,7 @@ func TestListTournamentsCmd_Run_HTTPMock(t *testing.T) {
 
 		gock.New("https://api.blast.tv").
 			Get(fmt.Sprintf("/v2/circuits/%s/tournaments", circuit)).
+			Times(3).
 			Reply(500)
 
 		cmd := &ListTournamentsCmd{
@@ -256,7 +258,7 @@ func TestListTournamentsCmd_Run_HTTPMock(t *testing.T) {
 				return fixedTime
 			},
 		}
-		ctx := &Context{Debug: false}
+		ctx := &Context{Debug: false, Client: blast.NewClient(blast.WithRetry(2, time.Millisecond))}
 
 		err := cmd.Run(ctx)
 		assert.Error(t, err)
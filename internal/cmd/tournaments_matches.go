@@ -1,20 +1,16 @@
 package cmd
 
 import (
-	"encoding/json"
 	"fmt"
 	"io"
-	"net/http"
 	"os"
 	"sort"
 	"strings"
-	"sync"
 	"time"
 
-	"github.com/mgranderath/rlcs-cli/internal/api/blast"
 	"github.com/mgranderath/rlcs-cli/internal/domain"
-	"github.com/mgranderath/rlcs-cli/internal/mapper"
 	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/teams"
 )
 
 // TournamentsMatchesCmd retrieves ongoing and upcoming games across tournaments in a circuit
@@ -25,11 +21,15 @@ type TournamentsMatchesCmd struct {
 	Major         bool               `help:"Show only major tournaments (empty region/grouping)"`
 	Grouping      string             `help:"Filter by tournament grouping (e.g., 'RLCS Open 1 2026')"`
 	MinTeams      int                `help:"Minimum number of teams"`
+	Team          []string           `help:"Show only the series of these teams (UUID, name or shorthand, repeatable)"`
 	LiveOnly      bool               `help:"Show only live matches"`
 	UpcomingOnly  bool               `help:"Show only upcoming matches"`
 	CompletedOnly bool               `help:"Show only completed matches"`
 	Limit         int                `help:"Maximum number of matches to return (after filtering)"`
 	Output        output.GamesFormat `help:"Output format (table, json, yaml)" default:"table" short:"o"`
+	Watch         WatchFlag          `help:"Keep polling and show score changes, optionally with the interval while live (e.g., --watch 10s)"`
+
+	FetchFlags `embed:""`
 
 	// now is a function that returns the current time, can be overridden for testing
 	now func() time.Time `kong:"-"`
@@ -57,84 +57,57 @@ func (l *TournamentsMatchesCmd) Run(ctx *Context) error {
 	if l.now == nil {
 		l.now = time.Now
 	}
-
 	circuit := l.Circuit
 	if circuit == "" {
 		circuit = fmt.Sprintf("%d", l.now().Year())
 	}
 
-	tournaments, err := l.fetchTournaments(circuit)
+	formatter, err := output.GetGamesFormatter(l.Output, l.now)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to get formatter: %w", err)
 	}
 
-	filteredTournaments := make([]domain.Tournament, 0, len(tournaments))
-	for _, t := range tournaments {
-		if l.matchesTournamentFilters(t) {
-			filteredTournaments = append(filteredTournaments, t)
+	// Changes are detected before the status filters, so that a series leaving the list is still reported
+	fetch := func() ([]domain.Match, func(io.Writer) error, error) {
+		tournaments, err := l.fetchCircuit(ctx, circuit, l.matchesTournamentFilters)
+		if err != nil {
+			return nil, nil, err
 		}
-	}
-
-	// Fetch matches concurrently to avoid N+1 API call problem
-	type tournamentResult struct {
-		tournament domain.Tournament
-		matches    []domain.Match
-		err        error
-	}
 
-	var wg sync.WaitGroup
-	results := make(chan tournamentResult, len(filteredTournaments))
-
-	for _, t := range filteredTournaments {
-		wg.Add(1)
-		go func(tournament domain.Tournament) {
-			defer wg.Done()
-			matches, err := l.fetchMatches(tournament.ID)
-			results <- tournamentResult{
-				tournament: tournament,
-				matches:    matches,
-				err:        err,
+		var all []domain.Match
+		games := make([]domain.GameListing, 0)
+		for _, t := range tournaments {
+			all = append(all, t.Matches...)
+			for _, match := range t.Matches {
+				if !l.matchesStatusFilter(match) || !l.matchesTeamFilter(match) {
+					continue
+				}
+				games = append(games, domain.GameListing{
+					TournamentID:   t.Tournament.ID,
+					TournamentName: t.Tournament.Name,
+					Match:          match,
+				})
 			}
-		}(t)
-	}
-
-	// Close the results channel once all goroutines complete
-	go func() {
-		wg.Wait()
-		close(results)
-	}()
-
-	// Collect all results
-	games := make([]domain.GameListing, 0)
-	for result := range results {
-		if result.err != nil {
-			return result.err
 		}
 
-		for _, match := range result.matches {
-			if !l.matchesStatusFilter(match) {
-				continue
-			}
-			games = append(games, domain.GameListing{
-				TournamentID:   result.tournament.ID,
-				TournamentName: result.tournament.Name,
-				Match:          match,
-			})
+		sortGames(games)
+
+		if l.Limit > 0 && len(games) > l.Limit {
+			games = games[:l.Limit]
 		}
+		return all, func(w io.Writer) error { return formatter.Format(w, games) }, nil
 	}
 
-	sortGames(games)
-
-	if l.Limit > 0 && len(games) > l.Limit {
-		games = games[:l.Limit]
+	if l.Watch.Enabled {
+		return newWatcher(ctx, l.Watch).run(ctx.requestContext(), fetch)
 	}
 
-	formatter, err := output.GetGamesFormatter(l.Output)
+	_, render, err := fetch()
 	if err != nil {
-		return fmt.Errorf("failed to get formatter: %w", err)
+		return err
 	}
 
-	if err := formatter.Format(os.Stdout, games); err != nil {
+	if err := render(os.Stdout); err != nil {
 		return fmt.Errorf("failed to format output: %w", err)
 	}
 
@@ -173,89 +146,16 @@ func (l *TournamentsMatchesCmd) matchesStatusFilter(match domain.Match) bool {
 	return match.IsLive || (!match.IsLive && !match.IsCompleted)
 }
 
-func (l *TournamentsMatchesCmd) fetchTournaments(circuit string) ([]domain.Tournament, error) {
-	url := fmt.Sprintf("%s/circuits/%s/tournaments?game=rl", blast.BaseURL, circuit)
-
-	client := &http.Client{
-		Timeout: 10 * time.Second,
-	}
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-	req.Header.Set("Accept", "application/json")
-
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	var apiTournaments []blast.Tournament
-	if err := json.Unmarshal(body, &apiTournaments); err != nil {
-		return nil, fmt.Errorf("failed to parse JSON: %w", err)
-	}
-
-	tournaments, err := mapper.ToDomainTournaments(apiTournaments)
-	if err != nil {
-		return nil, fmt.Errorf("failed to map tournaments: %w", err)
-	}
-
-	return tournaments, nil
-}
-
-func (l *TournamentsMatchesCmd) fetchMatches(tournamentID string) ([]domain.Match, error) {
-	url := fmt.Sprintf("%s/games/rl/tournaments/%s/matches", blast.BaseURL, tournamentID)
-
-	client := &http.Client{
-		Timeout: 10 * time.Second,
-	}
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-	req.Header.Set("Accept", "application/json")
-
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, fmt.Errorf("tournament not found: %s", tournamentID)
-	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	var apiMatches []blast.MatchResponse
-	if err := json.Unmarshal(body, &apiMatches); err != nil {
-		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+func (l *TournamentsMatchesCmd) matchesTeamFilter(match domain.Match) bool {
+	if len(l.Team) == 0 {
+		return true
 	}
-
-	matches, err := mapper.ToDomainMatchesFromResponse(apiMatches)
-	if err != nil {
-		return nil, fmt.Errorf("failed to map matches: %w", err)
+	for _, query := range l.Team {
+		if teams.IsParticipant(match.TeamA, query) || teams.IsParticipant(match.TeamB, query) {
+			return true
+		}
 	}
-
-	return matches, nil
+	return false
 }
 
 func sortGames(games []domain.GameListing) {
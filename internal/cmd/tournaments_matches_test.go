@@ -1,13 +1,25 @@
 package cmd
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/h2non/gock"
+	"github.com/mgranderath/rlcs-cli/internal/api/blast"
 	"github.com/mgranderath/rlcs-cli/internal/domain"
 	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/source"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTournamentsMatchesCmd_matchesStatusFilter(t *testing.T) {
@@ -37,6 +49,18 @@ func TestTournamentsMatchesCmd_matchesStatusFilter(t *testing.T) {
 	assert.True(t, cmd.matchesStatusFilter(completed))
 }
 
+func TestTournamentsMatchesCmd_matchesTeamFilter(t *testing.T) {
+	match := domain.Match{
+		TeamA: domain.MatchTeam{UUID: "vit", Name: "Team Vitality", Shorthand: "VIT"},
+		TeamB: domain.MatchTeam{UUID: "kc", Name: "Karmine Corp", Shorthand: "KC"},
+	}
+
+	assert.True(t, (&TournamentsMatchesCmd{}).matchesTeamFilter(match))
+	assert.True(t, (&TournamentsMatchesCmd{Team: []string{"G2", "karmine"}}).matchesTeamFilter(match))
+	assert.True(t, (&TournamentsMatchesCmd{Team: []string{"vit"}}).matchesTeamFilter(match))
+	assert.False(t, (&TournamentsMatchesCmd{Team: []string{"G2", "FLCN"}}).matchesTeamFilter(match))
+}
+
 func TestTournamentsMatchesCmd_matchesTournamentFilters(t *testing.T) {
 	cmd := &TournamentsMatchesCmd{
 		Region:   "EU",
@@ -159,3 +183,130 @@ func TestTournamentsMatchesCmd_Run_HTTPMock(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, gock.IsDone())
 }
+
+func TestTournamentsMatchesCmd_Run_Replay(t *testing.T) {
+	transport, err := blast.NewReplayTransport("testdata/replay/tournaments-matches")
+	require.NoError(t, err)
+
+	cmd := &TournamentsMatchesCmd{
+		Circuit: "2026",
+		Output:  output.GamesFormatJSON,
+	}
+
+	ctx := &Context{Client: blast.NewClient(blast.WithTransport(transport))}
+	err = cmd.Run(ctx)
+	assert.NoError(t, err)
+
+	// A circuit that was not recorded fails instead of hitting the network
+	cmd.Circuit = "2025"
+	err = cmd.Run(ctx)
+	assert.ErrorContains(t, err, "no recorded response")
+}
+
+func TestTournamentsMatchesCmd_Run_Snapshot(t *testing.T) {
+	src, err := source.NewSnapshotSource("../source/testdata/snapshot")
+	require.NoError(t, err)
+
+	cmd := &TournamentsMatchesCmd{
+		Circuit:       "2025",
+		CompletedOnly: true,
+		Output:        output.GamesFormatJSON,
+	}
+
+	var stderr bytes.Buffer
+	ctx := &Context{Source: src, stderr: &stderr}
+	err = cmd.Run(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, stderr.String(), "Warning: skipped tournament t2: failed to parse start date")
+	assert.Contains(t, stderr.String(), "Warning: skipped match m2: failed to parse scheduled time")
+
+	ctx = &Context{Source: src, Strict: true}
+	err = cmd.Run(ctx)
+	assert.ErrorContains(t, err, "failed to map tournaments: failed to map tournament t2")
+
+	// Without the broken tournament, strict mode fails on the broken match
+	dir := t.TempDir()
+	require.NoError(t, os.CopyFS(dir, os.DirFS("../source/testdata/snapshot")))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "circuits", "2025", "tournaments.json"), []byte(`[{"id":"t1","name":"RLCS 2025 Open 1 EU","startDate":"2025-01-10","endDate":"2025-01-12"}]`), 0o644))
+	src, err = source.NewSnapshotSource(dir)
+	require.NoError(t, err)
+
+	err = cmd.Run(&Context{Source: src, Strict: true})
+	assert.ErrorContains(t, err, "failed to fetch matches for RLCS 2025 Open 1 EU: failed to map matches: failed to map match m2")
+}
+
+func TestTournamentsMatchesCmd_fetchAllMatches(t *testing.T) {
+	tournaments := make([]domain.Tournament, 6)
+	for i := range tournaments {
+		tournaments[i] = domain.Tournament{ID: fmt.Sprintf("t%d", i), Name: fmt.Sprintf("Tournament %d", i)}
+	}
+
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		recorder := httptest.NewRecorder()
+		if strings.Contains(req.URL.Path, "/t3/") {
+			recorder.WriteHeader(http.StatusBadRequest)
+		} else {
+			recorder.WriteString("[]")
+		}
+		return recorder.Result(), nil
+	})
+	src := source.NewBlastSource(blast.NewClient(blast.WithTransport(transport)))
+
+	t.Run("bounded concurrency and keep going", func(t *testing.T) {
+		cmd := &TournamentsMatchesCmd{FetchFlags: FetchFlags{Concurrency: 2, KeepGoing: true}}
+
+		results, err := cmd.fetchAllMatches(context.Background(), src, tournaments)
+		require.NoError(t, err)
+		require.Len(t, results, 6)
+		assert.LessOrEqual(t, maxInFlight, 2)
+		for i, result := range results {
+			assert.Equal(t, tournaments[i].ID, result.tournament.ID)
+			if i == 3 {
+				assert.Error(t, result.err)
+			} else {
+				assert.NoError(t, result.err)
+			}
+		}
+
+		var stderr bytes.Buffer
+		cmd.stderr = &stderr
+		cmd.printFailures([]tournamentResult{results[3]}, len(results), "matches")
+		assert.Contains(t, stderr.String(), "Failed to fetch matches for 1 of 6 tournaments")
+		assert.Contains(t, stderr.String(), "Tournament 3 (t3): unexpected status code: 400")
+	})
+
+	t.Run("fails fast without keep going", func(t *testing.T) {
+		cmd := &TournamentsMatchesCmd{FetchFlags: FetchFlags{Concurrency: 2}}
+
+		_, err := cmd.fetchAllMatches(context.Background(), src, tournaments)
+		assert.EqualError(t, err, "failed to fetch matches for Tournament 3: unexpected status code: 400")
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		cmd := &TournamentsMatchesCmd{FetchFlags: FetchFlags{Concurrency: 2}}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := cmd.fetchAllMatches(ctx, src, tournaments)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/graph"
+	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/teams"
+)
+
+// TournamentsPathCmd shows the series a team still has to win to win a tournament
+type TournamentsPathCmd struct {
+	TournamentID string        `arg:"" help:"Tournament ID (UUID)"`
+	Team         string        `arg:"" help:"Team UUID, name or shorthand"`
+	Output       output.Format `help:"Output format (table, json, csv, yaml)" default:"table" short:"o"`
+}
+
+func (p *TournamentsPathCmd) Run(ctx *Context) error {
+	result, err := ctx.dataSource().TournamentBrackets(ctx.requestContext(), p.TournamentID)
+	if err != nil {
+		return err
+	}
+	if err := ctx.checkSkipped(result.Skipped); err != nil {
+		return fmt.Errorf("failed to map brackets: %w", err)
+	}
+
+	g := graph.Build(result.Items)
+	if err := ctx.checkGraph(g.Validate()); err != nil {
+		return err
+	}
+
+	team, err := findParticipant(g.Teams(), p.Team)
+	if err != nil {
+		return err
+	}
+
+	path, err := g.Path(team.UUID)
+	if err != nil {
+		return err
+	}
+
+	formatter, err := output.GetPathFormatter(p.Output)
+	if err != nil {
+		return fmt.Errorf("failed to get formatter: %w", err)
+	}
+
+	if err := formatter.Format(os.Stdout, path); err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+
+	return nil
+}
+
+// findParticipant resolves a team query to exactly one participant of a tournament.
+// An exact UUID match wins over an exact name or shorthand match, which wins over a partial match.
+func findParticipant(participants []domain.MatchTeam, query string) (domain.MatchTeam, error) {
+	var exact, partial []domain.MatchTeam
+	for _, team := range participants {
+		switch {
+		case team.UUID == query:
+			return team, nil
+		case strings.EqualFold(team.Name, query) || strings.EqualFold(team.Shorthand, query):
+			exact = append(exact, team)
+		case teams.Matches(team, query):
+			partial = append(partial, team)
+		}
+	}
+
+	found := exact
+	if len(found) == 0 {
+		found = partial
+	}
+	switch len(found) {
+	case 0:
+		return domain.MatchTeam{}, fmt.Errorf("team not found in tournament: %s", query)
+	case 1:
+		return found[0], nil
+	}
+
+	candidates := make([]string, 0, len(found))
+	for _, team := range found {
+		candidates = append(candidates, fmt.Sprintf("%s (%s)", team.Name, team.UUID))
+	}
+	return domain.MatchTeam{}, fmt.Errorf("team %q is ambiguous, matches: %s", query, strings.Join(candidates, ", "))
+}
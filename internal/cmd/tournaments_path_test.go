@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mgranderath/rlcs-cli/internal/graph"
+	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTournamentsPathCmd_Run_Snapshot(t *testing.T) {
+	src, err := source.NewSnapshotSource("testdata/snapshot")
+	require.NoError(t, err)
+
+	ctx := &Context{Source: src}
+	for _, format := range []output.Format{output.FormatTable, output.FormatJSON, output.FormatCSV, output.FormatYAML} {
+		cmd := &TournamentsPathCmd{TournamentID: "major", Team: "FLCN", Output: format}
+		require.NoError(t, cmd.Run(ctx))
+	}
+
+	for _, team := range []string{"vit", "Karmine Corp", "furia"} {
+		cmd := &TournamentsPathCmd{TournamentID: "major", Team: team, Output: output.FormatTable}
+		require.NoError(t, cmd.Run(ctx))
+	}
+
+	cmd := &TournamentsPathCmd{TournamentID: "major", Team: "BDS", Output: output.FormatTable}
+	assert.EqualError(t, cmd.Run(ctx), "team not found in tournament: BDS")
+
+	cmd = &TournamentsPathCmd{TournamentID: "major", Team: "Team", Output: output.FormatTable}
+	assert.EqualError(t, cmd.Run(ctx), `team "Team" is ambiguous, matches: Team Vitality (vit), Team Falcons (flcn)`)
+
+	cmd = &TournamentsPathCmd{TournamentID: "missing", Team: "VIT", Output: output.FormatTable}
+	assert.EqualError(t, cmd.Run(ctx), "tournament not found: missing")
+}
+
+func TestContext_CheckGraph(t *testing.T) {
+	issues := []graph.Issue{{Kind: graph.IssueDangling, Series: []string{"a"}, Message: "winner of Playoffs: Round 1 moves on to series b, which does not exist"}}
+
+	var stderr bytes.Buffer
+	ctx := &Context{stderr: &stderr}
+	require.NoError(t, ctx.checkGraph(nil))
+	require.NoError(t, ctx.checkGraph(issues))
+	assert.Equal(t, "Warning: bracket graph: dangling: winner of Playoffs: Round 1 moves on to series b, which does not exist\n", stderr.String())
+
+	ctx.Strict = true
+	assert.EqualError(t, ctx.checkGraph(issues), "invalid bracket graph: dangling: winner of Playoffs: Round 1 moves on to series b, which does not exist")
+}
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/placements"
+)
+
+// TournamentsResultsCmd derives the final placements of a tournament from its brackets
+type TournamentsResultsCmd struct {
+	TournamentID string        `arg:"" help:"Tournament ID (UUID)"`
+	Output       output.Format `help:"Output format (table, json, csv, yaml)" default:"table" short:"o"`
+}
+
+func (r *TournamentsResultsCmd) Run(ctx *Context) error {
+	result, err := ctx.dataSource().TournamentBrackets(ctx.requestContext(), r.TournamentID)
+	if err != nil {
+		return err
+	}
+	if err := ctx.checkSkipped(result.Skipped); err != nil {
+		return fmt.Errorf("failed to map brackets: %w", err)
+	}
+
+	results := placements.Compute(result.Items)
+
+	formatter, err := output.GetPlacementsFormatter(r.Output)
+	if err != nil {
+		return fmt.Errorf("failed to get formatter: %w", err)
+	}
+
+	if err := formatter.Format(os.Stdout, results); err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/simulate"
+)
+
+// TournamentsSimulateCmd estimates the chances of every team of a tournament with Monte Carlo simulations
+type TournamentsSimulateCmd struct {
+	TournamentID string        `arg:"" help:"Tournament ID (UUID)"`
+	Runs         int           `help:"Number of simulated tournaments" default:"10000"`
+	Seed         uint64        `help:"Seed of the random number generator for reproducible results (default: random)"`
+	Odds         string        `help:"YAML file with team ratings and series win probabilities to use instead of computed ratings" type:"path"`
+	Output       output.Format `help:"Output format (table, json, csv, yaml)" default:"table" short:"o"`
+
+	RatingFlags `embed:""`
+	FetchFlags  `embed:""`
+
+	// now is a function that returns the current time, can be overridden for testing
+	now func() time.Time `kong:"-"`
+}
+
+func (s *TournamentsSimulateCmd) Run(ctx *Context) error {
+	if s.Runs <= 0 {
+		return fmt.Errorf("runs must be positive")
+	}
+	if s.now == nil {
+		s.now = time.Now
+	}
+
+	result, err := ctx.dataSource().TournamentBrackets(ctx.requestContext(), s.TournamentID)
+	if err != nil {
+		return err
+	}
+	if err := ctx.checkSkipped(result.Skipped); err != nil {
+		return fmt.Errorf("failed to map brackets: %w", err)
+	}
+
+	model, err := s.model(ctx, result.Items)
+	if err != nil {
+		return err
+	}
+
+	seed := s.Seed
+	if seed == 0 {
+		seed = rand.Uint64()
+	}
+	simulation := simulate.Simulate(result.Items, model, s.Runs, seed)
+
+	formatter, err := output.GetSimulationFormatter(s.Output)
+	if err != nil {
+		return fmt.Errorf("failed to get formatter: %w", err)
+	}
+
+	if err := formatter.Format(os.Stdout, simulation); err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+
+	return nil
+}
+
+// model returns the model of the odds file if one is given, otherwise the ratings of all teams
+func (s *TournamentsSimulateCmd) model(ctx *Context, brackets []domain.Bracket) (simulate.Model, error) {
+	if s.Odds != "" {
+		odds, err := simulate.LoadOdds(s.Odds)
+		if err != nil {
+			return nil, err
+		}
+		return simulate.NewOddsModel(odds, brackets)
+	}
+
+	records, opts, err := s.replay(ctx, &s.FetchFlags, s.now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute ratings: %w", err)
+	}
+	return simulate.NewRatingsModel(records, opts), nil
+}
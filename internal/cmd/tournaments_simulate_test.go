@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTournamentsSimulateCmd_Run_Snapshot(t *testing.T) {
+	src, err := source.NewSnapshotSource("testdata/snapshot")
+	require.NoError(t, err)
+
+	ctx := &Context{Source: src}
+	now := func() time.Time { return time.Date(2026, 3, 4, 18, 0, 0, 0, time.UTC) }
+
+	t.Run("ratings", func(t *testing.T) {
+		for _, format := range []output.Format{output.FormatTable, output.FormatJSON, output.FormatCSV, output.FormatYAML} {
+			cmd := &TournamentsSimulateCmd{TournamentID: "major", Runs: 100, Seed: 1, Output: format, now: now}
+			require.NoError(t, cmd.Run(ctx))
+		}
+	})
+
+	t.Run("odds file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "odds.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("ratings:\n  VIT: 1700\nseries:\n  FLCN vs SSG: 0.6\n"), 0o644))
+
+		cmd := &TournamentsSimulateCmd{TournamentID: "major", Runs: 100, Odds: path, Output: output.FormatTable, now: now}
+		require.NoError(t, cmd.Run(ctx))
+
+		require.NoError(t, os.WriteFile(path, []byte("ratings:\n  BDS: 1700\n"), 0o644))
+		assert.EqualError(t, cmd.Run(ctx), `unknown team "BDS" in odds file`)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		cmd := &TournamentsSimulateCmd{TournamentID: "major", Runs: 0, now: now}
+		assert.EqualError(t, cmd.Run(ctx), "runs must be positive")
+
+		cmd = &TournamentsSimulateCmd{TournamentID: "missing", Runs: 10, now: now}
+		assert.EqualError(t, cmd.Run(ctx), "tournament not found: missing")
+
+		cmd = &TournamentsSimulateCmd{TournamentID: "major", Runs: 10, RatingFlags: RatingFlags{Circuit: "2027..2026"}, now: now}
+		assert.EqualError(t, cmd.Run(ctx), `failed to compute ratings: invalid circuit range "2027..2026": end is before start`)
+	})
+}
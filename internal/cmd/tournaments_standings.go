@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/standings"
+)
+
+// TournamentsStandingsCmd computes the standings of the Swiss and round-robin brackets of a tournament
+type TournamentsStandingsCmd struct {
+	TournamentID string        `arg:"" help:"Tournament ID (UUID)"`
+	Bracket      string        `help:"Filter by bracket name or label (case-insensitive partial match)"`
+	Output       output.Format `help:"Output format (table, json, csv, yaml)" default:"table" short:"o"`
+}
+
+func (s *TournamentsStandingsCmd) Run(ctx *Context) error {
+	result, err := ctx.dataSource().TournamentBrackets(ctx.requestContext(), s.TournamentID)
+	if err != nil {
+		return err
+	}
+	if err := ctx.checkSkipped(result.Skipped); err != nil {
+		return fmt.Errorf("failed to map brackets: %w", err)
+	}
+
+	brackets := make([]domain.Bracket, 0, len(result.Items))
+	for _, bracket := range result.Items {
+		if s.matchesBracket(bracket) {
+			brackets = append(brackets, bracket)
+		}
+	}
+
+	tables := standings.Compute(brackets)
+
+	formatter, err := output.GetStandingsFormatter(s.Output)
+	if err != nil {
+		return fmt.Errorf("failed to get formatter: %w", err)
+	}
+
+	if err := formatter.Format(os.Stdout, tables); err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+
+	return nil
+}
+
+func (s *TournamentsStandingsCmd) matchesBracket(bracket domain.Bracket) bool {
+	if s.Bracket == "" {
+		return true
+	}
+	query := strings.ToLower(s.Bracket)
+	return strings.Contains(strings.ToLower(bracket.TournamentName), query) ||
+		strings.Contains(strings.ToLower(bracket.Label), query)
+}
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/output"
+	"github.com/mgranderath/rlcs-cli/internal/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTournamentsStandingsCmd_Run_Snapshot(t *testing.T) {
+	src, err := source.NewSnapshotSource("testdata/snapshot")
+	require.NoError(t, err)
+
+	ctx := &Context{Source: src}
+	for _, format := range []output.Format{output.FormatTable, output.FormatJSON, output.FormatCSV, output.FormatYAML} {
+		cmd := &TournamentsStandingsCmd{TournamentID: "major", Output: format}
+		require.NoError(t, cmd.Run(ctx))
+	}
+
+	cmd := &TournamentsStandingsCmd{TournamentID: "missing", Output: output.FormatTable}
+	assert.EqualError(t, cmd.Run(ctx), "tournament not found: missing")
+}
+
+func TestTournamentsStandingsCmd_matchesBracket(t *testing.T) {
+	cmd := &TournamentsStandingsCmd{}
+	swiss := domain.Bracket{TournamentName: "Swiss Stage", Label: "Swiss"}
+	groups := domain.Bracket{TournamentName: "Group Stage", Label: "Group A"}
+
+	assert.True(t, cmd.matchesBracket(swiss))
+	assert.True(t, cmd.matchesBracket(groups))
+
+	cmd.Bracket = "group a"
+	assert.False(t, cmd.matchesBracket(swiss))
+	assert.True(t, cmd.matchesBracket(groups))
+}
@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/alecthomas/kong"
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/watch"
+)
+
+// clearScreen moves the cursor home and clears the terminal
+const clearScreen = "\x1b[H\x1b[2J"
+
+// WatchFlag is the --watch flag. It can be given without a value to poll at the default interval,
+// or with an interval, e.g. --watch 10s or --watch=10s.
+type WatchFlag struct {
+	Enabled  bool
+	Interval time.Duration
+}
+
+// IsBool allows the flag to be given without a value
+func (w *WatchFlag) IsBool() bool { return true }
+
+// Decode enables the flag and takes the next argument as interval if it is a duration
+func (w *WatchFlag) Decode(ctx *kong.DecodeContext) error {
+	w.Enabled = true
+	w.Interval = watch.DefaultInterval
+
+	token := ctx.Scan.Peek()
+	value, ok := token.Value.(string)
+	if !ok {
+		return nil
+	}
+	switch token.Type {
+	case kong.FlagValueToken:
+		ctx.Scan.Pop()
+		interval, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("invalid watch interval %q: %w", value, err)
+		}
+		w.Interval = interval
+	case kong.UntypedToken, kong.PositionalArgumentToken:
+		if interval, err := time.ParseDuration(value); err == nil {
+			ctx.Scan.Pop()
+			w.Interval = interval
+		}
+	}
+	if w.Interval <= 0 {
+		return fmt.Errorf("watch interval must be positive")
+	}
+	return nil
+}
+
+// poll fetches the current state of the watched matches and returns a function rendering them
+type poll func() ([]domain.Match, func(io.Writer) error, error)
+
+// watcher re-polls matches until it is interrupted. On a terminal the output is redrawn in place,
+// otherwise an event line is printed for every change.
+type watcher struct {
+	interval time.Duration
+	out      io.Writer
+	stderr   io.Writer
+	tty      bool
+
+	now   func() time.Time
+	after func(time.Duration) <-chan time.Time
+}
+
+// newWatcher returns a watcher writing to stdout
+func newWatcher(ctx *Context, flag WatchFlag) *watcher {
+	loc := ctx.location()
+	return &watcher{
+		interval: flag.Interval,
+		out:      os.Stdout,
+		stderr:   ctx.warnings(),
+		tty:      isTerminal(os.Stdout),
+		now:      func() time.Time { return time.Now().In(loc) },
+		after:    time.After,
+	}
+}
+
+// run polls until ctx is cancelled. An error of the first poll is returned, later errors are
+// reported on stderr and polling continues.
+func (w *watcher) run(ctx context.Context, fetch poll) error {
+	matches, render, err := fetch()
+	if err != nil {
+		return err
+	}
+	if err := w.draw(render, nil); err != nil {
+		return err
+	}
+	if !w.tty {
+		fmt.Fprintf(w.out, "%s Watching %s\n", w.timestamp(), describe(matches))
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-w.after(watch.NextPoll(matches, w.interval, w.now())):
+		}
+
+		current, render, err := fetch()
+		if errors.Is(err, context.Canceled) {
+			return nil
+		}
+		if err != nil {
+			fmt.Fprintf(w.stderr, "Warning: poll failed: %v\n", err)
+			continue
+		}
+
+		events := watch.Diff(matches, current)
+		matches = current
+		if err := w.draw(render, events); err != nil {
+			return err
+		}
+		if !w.tty {
+			for _, event := range events {
+				fmt.Fprintf(w.out, "%s %s\n", w.timestamp(), event)
+			}
+		}
+	}
+}
+
+// draw redraws the output on a terminal, followed by the events of the last poll
+func (w *watcher) draw(render func(io.Writer) error, events []watch.Event) error {
+	if !w.tty {
+		return nil
+	}
+
+	// Render into a buffer first so the screen is not blank while waiting for the formatter
+	var buf bytes.Buffer
+	buf.WriteString(clearScreen)
+	if err := render(&buf); err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+	fmt.Fprintf(&buf, "\nUpdated %s, polling every %s while live (Ctrl+C to stop)\n", w.timestamp(), w.interval)
+	for _, event := range events {
+		fmt.Fprintf(&buf, "  %s\n", event)
+	}
+	_, err := w.out.Write(buf.Bytes())
+	return err
+}
+
+func (w *watcher) timestamp() string {
+	return w.now().Format("15:04:05")
+}
+
+// describe summarizes the watched matches, e.g. "5 series (1 live)"
+func describe(matches []domain.Match) string {
+	live := 0
+	for _, match := range matches {
+		if match.IsLive {
+			live++
+		}
+	}
+	return fmt.Sprintf("%d series (%d live)", len(matches), live)
+}
+
+// isTerminal returns true if f is a character device, e.g. an interactive terminal
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
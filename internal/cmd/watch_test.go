@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/kong"
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchFlag_Decode(t *testing.T) {
+	var cli struct {
+		Watch WatchFlag `help:"Watch"`
+		ID    string    `arg:"" optional:""`
+	}
+	parser, err := kong.New(&cli)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		args     []string
+		enabled  bool
+		interval time.Duration
+		id       string
+	}{
+		{args: []string{"match"}, id: "match"},
+		{args: []string{"--watch", "match"}, enabled: true, interval: 30 * time.Second, id: "match"},
+		{args: []string{"match", "--watch"}, enabled: true, interval: 30 * time.Second, id: "match"},
+		{args: []string{"--watch", "10s", "match"}, enabled: true, interval: 10 * time.Second, id: "match"},
+		{args: []string{"--watch=1m", "match"}, enabled: true, interval: time.Minute, id: "match"},
+	} {
+		cli.Watch, cli.ID = WatchFlag{}, ""
+		_, err := parser.Parse(tc.args)
+		require.NoError(t, err, tc.args)
+		assert.Equal(t, tc.enabled, cli.Watch.Enabled, tc.args)
+		assert.Equal(t, tc.interval, cli.Watch.Interval, tc.args)
+		assert.Equal(t, tc.id, cli.ID, tc.args)
+	}
+
+	_, err = parser.Parse([]string{"--watch=soon"})
+	assert.ErrorContains(t, err, `invalid watch interval "soon"`)
+	_, err = parser.Parse([]string{"--watch=0s"})
+	assert.ErrorContains(t, err, "watch interval must be positive")
+}
+
+// watchStates returns a poll serving the states one after another, cancelling ctx after the last one
+func watchStates(cancel context.CancelFunc, states ...[]domain.Match) poll {
+	i := 0
+	return func() ([]domain.Match, func(io.Writer) error, error) {
+		if i >= len(states) {
+			cancel()
+			return nil, nil, context.Canceled
+		}
+		matches := states[i]
+		i++
+		if matches == nil {
+			return nil, nil, errors.New("unavailable")
+		}
+		return matches, func(w io.Writer) error {
+			_, err := fmt.Fprintf(w, "%d-%d", matches[0].TeamAScore, matches[0].TeamBScore)
+			return err
+		}, nil
+	}
+}
+
+func testWatcher(tty bool) (*watcher, *bytes.Buffer, *bytes.Buffer) {
+	var out, stderr bytes.Buffer
+	now := time.Date(2026, 3, 5, 18, 30, 0, 0, time.UTC)
+	return &watcher{
+		interval: 30 * time.Second,
+		out:      &out,
+		stderr:   &stderr,
+		tty:      tty,
+		now:      func() time.Time { return now },
+		after: func(time.Duration) <-chan time.Time {
+			ch := make(chan time.Time, 1)
+			ch <- now
+			return ch
+		},
+	}, &out, &stderr
+}
+
+func watchedSeries(scoreA, scoreB int, completed bool) []domain.Match {
+	return []domain.Match{{
+		UUID:        "final",
+		TeamA:       domain.MatchTeam{UUID: "vit", Name: "Team Vitality"},
+		TeamB:       domain.MatchTeam{UUID: "kc", Name: "Karmine Corp"},
+		TeamAScore:  scoreA,
+		TeamBScore:  scoreB,
+		IsLive:      !completed,
+		IsCompleted: completed,
+	}}
+}
+
+func TestWatcher_Run_EventLines(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w, out, stderr := testWatcher(false)
+
+	fetch := watchStates(cancel, watchedSeries(2, 1, false), nil, watchedSeries(3, 1, false), watchedSeries(4, 2, true))
+	require.NoError(t, w.run(ctx, fetch))
+
+	assert.Equal(t, "18:30:00 Watching 1 series (1 live)\n"+
+		"18:30:00 Team Vitality vs Karmine Corp: Team Vitality wins game 4 (3-1)\n"+
+		"18:30:00 Team Vitality vs Karmine Corp: Karmine Corp wins game 5 (3-2)\n"+
+		"18:30:00 Team Vitality vs Karmine Corp: Team Vitality wins game 6 (4-2)\n"+
+		"18:30:00 Team Vitality vs Karmine Corp: Series over 4-2\n", out.String())
+	assert.Equal(t, "Warning: poll failed: unavailable\n", stderr.String())
+}
+
+func TestWatcher_Run_Redraw(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w, out, _ := testWatcher(true)
+
+	fetch := watchStates(cancel, watchedSeries(2, 1, false), watchedSeries(3, 1, false))
+	require.NoError(t, w.run(ctx, fetch))
+
+	frames := bytes.Split(out.Bytes(), []byte(clearScreen))
+	require.Len(t, frames, 3)
+	assert.Contains(t, string(frames[1]), "2-1")
+	assert.Contains(t, string(frames[2]), "3-1")
+	assert.Contains(t, string(frames[2]), "  Team Vitality vs Karmine Corp: Team Vitality wins game 4 (3-1)\n")
+}
+
+func TestWatcher_Run_FirstPollFails(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w, _, _ := testWatcher(false)
+
+	assert.EqualError(t, w.run(ctx, watchStates(cancel, nil)), "unavailable")
+}
@@ -0,0 +1,129 @@
+// Package config loads the optional rlcs-cli configuration file
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config holds settings that apply to every command.
+// Command line flags take precedence over the values in the file.
+type Config struct {
+	// Source is the data source: "blast" for the live API or a snapshot directory
+	Source string `yaml:"source"`
+	// Timezone is the IANA name of the time zone times are shown in (e.g., Europe/Paris), defaults to the local zone
+	Timezone string `yaml:"timezone"`
+	// PointsTable is the YAML file with the circuit points awarded per tournament type and placement
+	PointsTable string `yaml:"points_table"`
+	// Ratings configures the team rating engine
+	Ratings Ratings `yaml:"ratings"`
+	// Notify configures the webhooks match events are posted to
+	Notify Notify `yaml:"notify"`
+	// Follow lists the teams and regions shown by the today and week commands
+	Follow Follow `yaml:"follow"`
+	// Cache configures the on-disk response cache
+	Cache Cache `yaml:"cache"`
+}
+
+// Cache holds the settings of the on-disk response cache
+type Cache struct {
+	// TTL configures how long cached responses are fresh per endpoint
+	TTL CacheTTL `yaml:"ttl"`
+}
+
+// CacheTTL holds the freshness lifetimes of cached responses, zero values select the defaults
+type CacheTTL struct {
+	// Circuits applies to the tournament list of a circuit
+	Circuits time.Duration `yaml:"circuits"`
+	// Finished applies to tournaments and matches where every series is over
+	Finished time.Duration `yaml:"finished"`
+	// Live applies to everything that may still change
+	Live time.Duration `yaml:"live"`
+}
+
+// Ratings holds the parameters of the team rating engine, zero values select the defaults
+type Ratings struct {
+	// System is the rating system: elo or glicko2
+	System string `yaml:"system"`
+	// KFactor is the Elo K-factor
+	KFactor float64 `yaml:"k_factor"`
+	// By selects whether series or individual games are rated: series or game
+	By string `yaml:"by"`
+	// Initial is the rating of teams that have not played yet
+	Initial float64 `yaml:"initial"`
+	// Seeds maps regions to the initial rating of teams first seen in a regional tournament of the region
+	Seeds map[string]float64 `yaml:"seeds"`
+}
+
+// Notify holds the settings of the match event notifications
+type Notify struct {
+	// UpsetProbability is the win probability below which a series win counts as an upset
+	UpsetProbability float64 `yaml:"upset_probability"`
+	// Webhooks lists the endpoints events are posted to
+	Webhooks []Webhook `yaml:"webhooks"`
+}
+
+// Webhook is an endpoint match events are posted to
+type Webhook struct {
+	// Name identifies the webhook, defaults to its URL
+	Name string `yaml:"name"`
+	URL  string `yaml:"url"`
+	// Format is the payload format: json, discord or slack
+	Format string `yaml:"format"`
+	// Teams restricts the webhook to the series of these teams (UUID, name or shorthand)
+	Teams []string `yaml:"teams"`
+	// Events restricts the webhook to these event kinds
+	Events []string `yaml:"events"`
+}
+
+// Follow holds the teams and regions a user follows
+type Follow struct {
+	// Teams lists the followed teams (UUID, name or shorthand)
+	Teams []string `yaml:"teams"`
+	// Regions lists the regions whose regional tournaments are followed
+	Regions []string `yaml:"regions"`
+}
+
+// DefaultPath returns the path of the configuration file
+func DefaultPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to determine user config dir: %w", err)
+	}
+	return filepath.Join(dir, "rlcs-cli", "config.yaml"), nil
+}
+
+// Load reads the configuration file at path. The file must exist.
+func Load(path string) (*Config, error) {
+	var cfg Config
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+// LoadDefault reads the configuration file at the default path.
+// The file is optional, an empty configuration is returned if it or the user config dir does not exist.
+func LoadDefault() (*Config, error) {
+	path, err := DefaultPath()
+	if err != nil {
+		return &Config{}, nil
+	}
+	cfg, err := Load(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &Config{}, nil
+	}
+	return cfg, err
+}
@@ -0,0 +1,115 @@
+package config
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := Load(filepath.Join(dir, "missing.yaml"))
+		assert.ErrorIs(t, err, fs.ErrNotExist)
+		assert.ErrorContains(t, err, "failed to read config: ")
+	})
+
+	t.Run("valid file", func(t *testing.T) {
+		path := filepath.Join(dir, "config.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("source: /data/rlcs-2025\npoints_table: /data/points.yaml\ntimezone: America/New_York\n"), 0o644))
+
+		cfg, err := Load(path)
+		require.NoError(t, err)
+		assert.Equal(t, "/data/rlcs-2025", cfg.Source)
+		assert.Equal(t, "/data/points.yaml", cfg.PointsTable)
+		assert.Equal(t, "America/New_York", cfg.Timezone)
+	})
+
+	t.Run("cache", func(t *testing.T) {
+		path := filepath.Join(dir, "cache.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("cache:\n  ttl:\n    circuits: 6h\n    live: 10s\n"), 0o644))
+
+		cfg, err := Load(path)
+		require.NoError(t, err)
+		assert.Equal(t, CacheTTL{Circuits: 6 * time.Hour, Live: 10 * time.Second}, cfg.Cache.TTL)
+	})
+
+	t.Run("ratings", func(t *testing.T) {
+		path := filepath.Join(dir, "ratings.yaml")
+		data := "ratings:\n  system: glicko2\n  k_factor: 24\n  by: game\n  initial: 1400\n  seeds:\n    EU: 1600\n    OCE: 1350\n"
+		require.NoError(t, os.WriteFile(path, []byte(data), 0o644))
+
+		cfg, err := Load(path)
+		require.NoError(t, err)
+		assert.Equal(t, Ratings{
+			System:  "glicko2",
+			KFactor: 24,
+			By:      "game",
+			Initial: 1400,
+			Seeds:   map[string]float64{"EU": 1600, "OCE": 1350},
+		}, cfg.Ratings)
+	})
+
+	t.Run("notify", func(t *testing.T) {
+		path := filepath.Join(dir, "notify.yaml")
+		data := "notify:\n  upset_probability: 0.3\n  webhooks:\n    - name: team\n      url: https://discord.com/api/webhooks/1/x\n      format: discord\n      teams: [KC]\n      events: [series_won, upset]\n"
+		require.NoError(t, os.WriteFile(path, []byte(data), 0o644))
+
+		cfg, err := Load(path)
+		require.NoError(t, err)
+		assert.Equal(t, Notify{
+			UpsetProbability: 0.3,
+			Webhooks: []Webhook{{
+				Name:   "team",
+				URL:    "https://discord.com/api/webhooks/1/x",
+				Format: "discord",
+				Teams:  []string{"KC"},
+				Events: []string{"series_won", "upset"},
+			}},
+		}, cfg.Notify)
+	})
+
+	t.Run("follow", func(t *testing.T) {
+		path := filepath.Join(dir, "follow.yaml")
+		data := "follow:\n  teams: [vit, Karmine Corp]\n  regions: [EU]\n"
+		require.NoError(t, os.WriteFile(path, []byte(data), 0o644))
+
+		cfg, err := Load(path)
+		require.NoError(t, err)
+		assert.Equal(t, Follow{Teams: []string{"vit", "Karmine Corp"}, Regions: []string{"EU"}}, cfg.Follow)
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		path := filepath.Join(dir, "invalid.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("source: [\n"), 0o644))
+
+		_, err := Load(path)
+		assert.ErrorContains(t, err, "failed to parse config")
+	})
+}
+
+func TestLoadDefault(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("AppData", dir)
+
+	// The default file is optional
+	cfg, err := LoadDefault()
+	require.NoError(t, err)
+	assert.Equal(t, &Config{}, cfg)
+
+	path, err := DefaultPath()
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte("timezone: UTC\n"), 0o644))
+	cfg, err = LoadDefault()
+	require.NoError(t, err)
+	assert.Equal(t, "UTC", cfg.Timezone)
+}
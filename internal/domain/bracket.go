@@ -1,6 +1,10 @@
 package domain
 
-import "time"
+import (
+	"strconv"
+	"strings"
+	"time"
+)
 
 // Bracket represents a tournament bracket
 type Bracket struct {
@@ -18,6 +22,28 @@ type Bracket struct {
 	Matches                []Match
 }
 
+// IsSwiss returns true if the bracket is played in Swiss format
+func (b *Bracket) IsSwiss() bool {
+	return strings.Contains(normalizeFormat(b.Format), "swiss")
+}
+
+// IsRoundRobin returns true if every team of the bracket plays every other team (e.g., groups)
+func (b *Bracket) IsRoundRobin() bool {
+	format := normalizeFormat(b.Format)
+	return strings.Contains(format, "roundrobin") || strings.HasPrefix(format, "rr")
+}
+
+// normalizeFormat lowercases a bracket format and strips separators,
+// so that "round-robin-4", "Round Robin" and "round_robin" compare equal
+func normalizeFormat(format string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == '_' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(format))
+}
+
 // Match represents a match in a bracket
 type Match struct {
 	UUID         string
@@ -37,6 +63,44 @@ type Match struct {
 	IsCompleted  bool
 }
 
+// BestOf returns the maximum number of games of the match from its type (e.g., 7 for "BO7"), zero if unknown
+func (m Match) BestOf() int {
+	value, ok := strings.CutPrefix(strings.ToUpper(strings.TrimSpace(m.Type)), "BO")
+	if !ok {
+		return 0
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		return 0
+	}
+	return n
+}
+
+// IsOver returns true if the match is completed and a team has won the majority of its games.
+// The status of matches is inferred from their games, so a match between two games looks completed
+// until the series score decides it. Matches of unknown length are over once they are completed.
+func (m Match) IsOver() bool {
+	if !m.IsCompleted {
+		return false
+	}
+	return m.BestOf() == 0 || m.IsDecided()
+}
+
+// IsDecided returns true if a team has won the majority of the games of the match, false if its length is unknown
+func (m Match) IsDecided() bool {
+	bestOf := m.BestOf()
+	if bestOf == 0 {
+		return false
+	}
+	wins := bestOf/2 + 1
+	return m.TeamAScore >= wins || m.TeamBScore >= wins
+}
+
+// IsInProgress returns true if a game of the match is being played or the match is between two games
+func (m Match) IsInProgress() bool {
+	return m.IsLive || (m.IsCompleted && !m.IsOver())
+}
+
 // MatchTeam represents a team in a match
 type MatchTeam struct {
 	UUID         string
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBracket_Format(t *testing.T) {
+	tests := []struct {
+		format     string
+		swiss      bool
+		roundRobin bool
+	}{
+		{format: "swiss-16", swiss: true},
+		{format: "Swiss", swiss: true},
+		{format: "round-robin-4", roundRobin: true},
+		{format: "Round Robin", roundRobin: true},
+		{format: "rr-4", roundRobin: true},
+		{format: "single-elim-8"},
+		{format: "double-elim-8"},
+		{format: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			bracket := Bracket{Format: tt.format}
+			assert.Equal(t, tt.swiss, bracket.IsSwiss())
+			assert.Equal(t, tt.roundRobin, bracket.IsRoundRobin())
+		})
+	}
+}
+
+func TestMatch_BestOf(t *testing.T) {
+	tests := map[string]int{
+		"BO7":   7,
+		" bo5 ": 5,
+		"BO3":   3,
+		"BO0":   0,
+		"BO":    0,
+		"Swiss": 0,
+		"":      0,
+	}
+
+	for matchType, want := range tests {
+		assert.Equal(t, want, Match{Type: matchType}.BestOf(), matchType)
+	}
+}
+
+func TestMatch_IsOver(t *testing.T) {
+	tests := []struct {
+		name       string
+		match      Match
+		over       bool
+		inProgress bool
+		decided    bool
+	}{
+		{"upcoming", Match{Type: "BO5"}, false, false, false},
+		{"live", Match{Type: "BO5", TeamAScore: 1, IsLive: true}, false, true, false},
+		{"between games", Match{Type: "BO5", TeamAScore: 1, IsCompleted: true}, false, true, false},
+		{"decided", Match{Type: "BO5", TeamAScore: 1, TeamBScore: 3, IsCompleted: true}, true, false, true},
+		{"unknown length", Match{Type: "Swiss", TeamAScore: 1, IsCompleted: true}, true, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.over, tt.match.IsOver())
+			assert.Equal(t, tt.inProgress, tt.match.IsInProgress())
+			assert.Equal(t, tt.decided, tt.match.IsDecided())
+		})
+	}
+}
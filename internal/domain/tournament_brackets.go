@@ -0,0 +1,7 @@
+package domain
+
+// TournamentBrackets holds all brackets of a tournament
+type TournamentBrackets struct {
+	Tournament Tournament
+	Brackets   []Bracket
+}
@@ -0,0 +1,7 @@
+package domain
+
+// TournamentMatches holds all matches of a tournament
+type TournamentMatches struct {
+	Tournament Tournament
+	Matches    []Match
+}
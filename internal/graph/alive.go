@@ -0,0 +1,125 @@
+package graph
+
+import (
+	"sort"
+	"strings"
+)
+
+// Risk describes what happens to a team that loses its next series
+type Risk string
+
+const (
+	// RiskUpper means a loss drops the team to another series, e.g. from the upper to the lower bracket
+	RiskUpper Risk = "Upper"
+	// RiskElimination means a loss eliminates the team, e.g. in the lower bracket or a single-elimination bracket
+	RiskElimination Risk = "Elimination"
+	// RiskUnknown means the next series is not linked, e.g. in Swiss and round-robin brackets
+	RiskUnknown Risk = ""
+)
+
+// Remaining is a team that is not eliminated yet
+type Remaining struct {
+	TeamUUID  string
+	Team      string
+	Shorthand string
+	Status    Status
+	// Bracket is the bracket of the next series of the team, or of its last series if no next series is known
+	Bracket string
+	// SeriesWins and SeriesLosses are the record of the team in Bracket
+	SeriesWins   int
+	SeriesLosses int
+	Risk         Risk
+	// Next is the next series of the team, nil if it is not known yet
+	Next *Step
+}
+
+// Alive returns every team that is not eliminated, the winner of a finished tournament included.
+// Teams are eliminated when they lose a series without a loser destination in an elimination bracket,
+// or when they are flagged as eliminated in their last series. Teams playing live come first,
+// followed by teams in the order of their next series and teams whose next series is not known.
+func (g *Graph) Alive() []Remaining {
+	var remaining []Remaining
+	for _, team := range g.Teams() {
+		if g.flaggedEliminated(team.UUID) {
+			continue
+		}
+		path, err := g.Path(team.UUID)
+		if err != nil || path.Status == StatusEliminated {
+			continue
+		}
+
+		r := Remaining{TeamUUID: path.TeamUUID, Team: path.Team, Shorthand: path.Shorthand, Status: path.Status}
+		if len(path.Steps) > 0 {
+			next := path.Steps[0]
+			r.Next = &next
+			r.Bracket = next.Bracket
+			r.Risk = risk(g.nodes[next.SeriesUUID])
+		} else if last := g.last(team.UUID); last != nil {
+			r.Bracket = last.Bracket
+		}
+		r.SeriesWins, r.SeriesLosses = g.record(team.UUID, r.Bracket)
+		remaining = append(remaining, r)
+	}
+
+	sort.SliceStable(remaining, func(i, j int) bool {
+		a, b := remaining[i].Next, remaining[j].Next
+		switch {
+		case a == nil || b == nil:
+			if (a == nil) != (b == nil) {
+				return b == nil
+			}
+		case a.Live != b.Live:
+			return a.Live
+		case !a.Time.Equal(b.Time):
+			return a.Time.Before(b.Time)
+		}
+		return strings.ToLower(remaining[i].Team) < strings.ToLower(remaining[j].Team)
+	})
+	return remaining
+}
+
+// risk returns what happens to the loser of a series
+func risk(node *Node) Risk {
+	switch {
+	case node.Loser != nil:
+		return RiskUpper
+	case node.grouped:
+		return RiskUnknown
+	}
+	return RiskElimination
+}
+
+// flaggedEliminated returns true if the team is flagged as eliminated in the last series it is listed in
+func (g *Graph) flaggedEliminated(teamUUID string) bool {
+	last := g.last(teamUUID)
+	return last != nil && eliminated(last, teamUUID)
+}
+
+// last returns the last series the team is listed in
+func (g *Graph) last(teamUUID string) *Node {
+	var last *Node
+	for _, node := range g.order {
+		if _, ok := slotOf(node, teamUUID); ok && (last == nil || later(node, last)) {
+			last = node
+		}
+	}
+	return last
+}
+
+// record returns the series won and lost by a team in the completed series of a bracket
+func (g *Graph) record(teamUUID, bracket string) (wins, losses int) {
+	for _, node := range g.order {
+		if node.Bracket != bracket || !node.Match.IsCompleted || node.Match.TeamAScore == node.Match.TeamBScore {
+			continue
+		}
+		if _, ok := slotOf(node, teamUUID); !ok {
+			continue
+		}
+		if wonBy(node, teamUUID) {
+			wins++
+		} else {
+			losses++
+		}
+	}
+	return wins, losses
+}
@@ -0,0 +1,87 @@
+package graph
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlive(t *testing.T) {
+	swiss := domain.Bracket{
+		Index:  0,
+		Label:  "Swiss",
+		Format: "swiss-4",
+		Matches: []domain.Match{
+			{UUID: "s1", Index: 0, Name: "Round 1", TeamA: team("A"), TeamB: team("E"), TeamAScore: 3, TeamBScore: 0, IsCompleted: true},
+			{UUID: "s2", Index: 1, Name: "Round 2", TeamA: team("E"), TeamB: team("F"), TeamAScore: 3, TeamBScore: 1, IsCompleted: true},
+			{UUID: "s3", Index: 2, Name: "Round 3", TeamA: team("E"), TeamB: team("G"), TimeOfSeries: time.Date(2026, 3, 2, 18, 0, 0, 0, time.UTC)},
+		},
+	}
+	swiss.Matches[1].TeamB.IsEliminated = true
+
+	playoffs := doubleElimination()
+	playoffs.Index = 1
+	// A beat B, D leads C live and the upper final is scheduled
+	playoffs.Matches[0].IsCompleted, playoffs.Matches[0].TeamAScore, playoffs.Matches[0].TeamBScore = true, 3, 1
+	playoffs.Matches[1].IsLive, playoffs.Matches[1].TeamAScore, playoffs.Matches[1].TeamBScore = true, 1, 2
+	playoffs.Matches[2].TeamA = team("A")
+	playoffs.Matches[2].TimeOfSeries = time.Date(2026, 3, 5, 18, 0, 0, 0, time.UTC)
+	playoffs.Matches[3].TeamA = team("B")
+	playoffs.Matches[3].TimeOfSeries = time.Date(2026, 3, 5, 15, 0, 0, 0, time.UTC)
+
+	remaining := Build([]domain.Bracket{swiss, playoffs}).Alive()
+
+	var order []string
+	for _, r := range remaining {
+		order = append(order, r.TeamUUID)
+	}
+	// F is flagged as eliminated, C and D play live, E and G play the earliest scheduled series
+	assert.Equal(t, []string{"C", "D", "E", "G", "B", "A"}, order)
+
+	byTeam := make(map[string]Remaining)
+	for _, r := range remaining {
+		byTeam[r.TeamUUID] = r
+	}
+
+	a := byTeam["A"]
+	assert.Equal(t, "Playoffs", a.Bracket)
+	assert.Equal(t, 1, a.SeriesWins)
+	assert.Equal(t, RiskUpper, a.Risk)
+	require.NotNil(t, a.Next)
+	assert.Equal(t, "Upper Final", a.Next.Series)
+	assert.Equal(t, "Winner of Upper Semifinal 2", a.Next.Opponent)
+
+	b := byTeam["B"]
+	assert.Equal(t, RiskElimination, b.Risk)
+	assert.Equal(t, 1, b.SeriesLosses)
+	assert.Equal(t, "Loser of Upper Semifinal 2", b.Next.Opponent)
+
+	e := byTeam["E"]
+	assert.Equal(t, "Swiss", e.Bracket)
+	assert.Equal(t, 1, e.SeriesWins)
+	assert.Equal(t, 1, e.SeriesLosses)
+	assert.Equal(t, RiskUnknown, e.Risk)
+	assert.Equal(t, "Team G", e.Next.Opponent)
+
+	assert.True(t, byTeam["D"].Next.Live)
+}
+
+func TestAlive_Finished(t *testing.T) {
+	bracket := domain.Bracket{
+		Label:  "Playoffs",
+		Format: "single-elim-2",
+		Matches: []domain.Match{
+			{UUID: "final", Name: "Grand Final", TeamA: team("A"), TeamB: team("B"), TeamAScore: 4, TeamBScore: 2, IsCompleted: true},
+		},
+	}
+
+	remaining := Build([]domain.Bracket{bracket}).Alive()
+	require.Len(t, remaining, 1)
+	assert.Equal(t, "A", remaining[0].TeamUUID)
+	assert.Equal(t, StatusWinner, remaining[0].Status)
+	assert.Equal(t, "Playoffs", remaining[0].Bracket)
+	assert.Nil(t, remaining[0].Next)
+}
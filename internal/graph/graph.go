@@ -0,0 +1,280 @@
+// Package graph links the series of a tournament through the destinations of their winners and losers
+package graph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/standings"
+)
+
+// Node is a series of the tournament together with the series its winner and loser move on to
+type Node struct {
+	Match domain.Match
+	// Bracket is the label of the bracket the series is played in
+	Bracket string
+	// Winner is the series the winner moves on to, nil if the winner leaves the graph
+	Winner *Node
+	// Loser is the series the loser drops to, nil if the loser leaves the graph
+	Loser *Node
+	// Sources are the series whose winner or loser moves on to this series
+	Sources []Edge
+
+	// position is the position of the bracket in the tournament
+	position int
+	// grouped is true if the series is played in a Swiss or round-robin bracket,
+	// whose series are usually not linked to each other
+	grouped bool
+}
+
+// Edge links a series to the series its winner or loser moves on to
+type Edge struct {
+	From *Node
+	// Loser is true if the loser of From moves on, otherwise the winner
+	Loser bool
+	// Position is the slot (A or B) the team takes in the destination, empty if unknown
+	Position string
+}
+
+// IssueKind is the kind of a problem of the graph
+type IssueKind string
+
+const (
+	// IssueDangling is a destination that refers to a series that does not exist
+	IssueDangling IssueKind = "dangling"
+	// IssueCycle is a chain of destinations that leads back to a series it started from
+	IssueCycle IssueKind = "cycle"
+)
+
+// Issue is a problem of the graph
+type Issue struct {
+	Kind IssueKind
+	// Series holds the UUIDs of the series involved, in the order of the chain for cycles
+	Series  []string
+	Message string
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%s: %s", i.Kind, i.Message)
+}
+
+// Graph holds the series of all brackets of a tournament
+type Graph struct {
+	nodes    map[string]*Node
+	order    []*Node
+	dangling []Issue
+	final    *Node
+}
+
+// Build links the series of the brackets. Brackets are ordered by their index and series by their index.
+// Destinations that refer to series that are not part of the brackets are reported by Validate.
+func Build(brackets []domain.Bracket) *Graph {
+	ordered := make([]domain.Bracket, len(brackets))
+	copy(ordered, brackets)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Index < ordered[j].Index
+	})
+
+	g := &Graph{nodes: make(map[string]*Node)}
+	for i, bracket := range ordered {
+		matches := make([]domain.Match, len(bracket.Matches))
+		copy(matches, bracket.Matches)
+		sort.SliceStable(matches, func(i, j int) bool {
+			return matches[i].Index < matches[j].Index
+		})
+
+		for _, match := range matches {
+			if match.UUID == "" {
+				continue
+			}
+			node := &Node{Match: match, Bracket: bracket.Label, position: i, grouped: standings.Supported(bracket)}
+			g.nodes[match.UUID] = node
+			g.order = append(g.order, node)
+		}
+	}
+
+	for _, node := range g.order {
+		node.Winner = g.link(node, node.Match.WinnerGoesTo, false)
+		node.Loser = g.link(node, node.Match.LoserGoesTo, true)
+	}
+
+	g.final = g.findFinal()
+	return g
+}
+
+// link resolves a destination of a series and records the edge at the destination
+func (g *Graph) link(from *Node, destination *domain.BracketDestination, loser bool) *Node {
+	if destination == nil || destination.SeriesUUID == "" {
+		return nil
+	}
+
+	role := "winner"
+	if loser {
+		role = "loser"
+	}
+	to, ok := g.nodes[destination.SeriesUUID]
+	if !ok {
+		g.dangling = append(g.dangling, Issue{
+			Kind:    IssueDangling,
+			Series:  []string{from.Match.UUID},
+			Message: fmt.Sprintf("%s of %s moves on to series %s, which does not exist", role, Label(from), destination.SeriesUUID),
+		})
+		return nil
+	}
+
+	to.Sources = append(to.Sources, Edge{From: from, Loser: loser, Position: strings.ToUpper(destination.BracketPosition)})
+	return to
+}
+
+// findFinal returns the series that decides the tournament: the series without a winner destination
+// of the last bracket that is not Swiss or round robin. If there are several (e.g., a match for
+// third place), the one the winners of the most series lead up to wins.
+func (g *Graph) findFinal() *Node {
+	last := -1
+	for _, node := range g.order {
+		if !node.grouped && node.position > last {
+			last = node.position
+		}
+	}
+
+	var final *Node
+	best := -1
+	for _, node := range g.order {
+		if node.position != last || node.Winner != nil {
+			continue
+		}
+		if feeders := countWinnerFeeders(node); feeders >= best {
+			final, best = node, feeders
+		}
+	}
+	return final
+}
+
+// countWinnerFeeders returns the number of series whose winners eventually reach node
+func countWinnerFeeders(node *Node) int {
+	seen := map[*Node]bool{node: true}
+	queue := []*Node{node}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, edge := range current.Sources {
+			if !edge.Loser && !seen[edge.From] {
+				seen[edge.From] = true
+				queue = append(queue, edge.From)
+			}
+		}
+	}
+	return len(seen) - 1
+}
+
+// Node returns the series with the given UUID
+func (g *Graph) Node(uuid string) (*Node, bool) {
+	node, ok := g.nodes[uuid]
+	return node, ok
+}
+
+// Nodes returns all series in bracket and series order
+func (g *Graph) Nodes() []*Node {
+	return g.order
+}
+
+// Final returns the series that decides the tournament, nil if there is none
+func (g *Graph) Final() *Node {
+	return g.final
+}
+
+// Teams returns all teams listed in a series, in order of appearance
+func (g *Graph) Teams() []domain.MatchTeam {
+	seen := make(map[string]int)
+	var teams []domain.MatchTeam
+	for _, node := range g.order {
+		for _, team := range []domain.MatchTeam{node.Match.TeamA, node.Match.TeamB} {
+			if team.UUID == "" {
+				continue
+			}
+			if i, ok := seen[team.UUID]; ok {
+				teams[i] = team
+				continue
+			}
+			seen[team.UUID] = len(teams)
+			teams = append(teams, team)
+		}
+	}
+	return teams
+}
+
+// Validate reports destinations that refer to series that do not exist and
+// chains of destinations that lead back to a series they started from
+func (g *Graph) Validate() []Issue {
+	issues := make([]Issue, 0, len(g.dangling))
+	issues = append(issues, g.dangling...)
+
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[*Node]int, len(g.order))
+	var stack []*Node
+
+	var visit func(node *Node)
+	visit = func(node *Node) {
+		state[node] = visiting
+		stack = append(stack, node)
+		for _, next := range []*Node{node.Winner, node.Loser} {
+			if next == nil {
+				continue
+			}
+			switch state[next] {
+			case unvisited:
+				visit(next)
+			case visiting:
+				issues = append(issues, cycle(stack, next))
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[node] = done
+	}
+
+	for _, node := range g.order {
+		if state[node] == unvisited {
+			visit(node)
+		}
+	}
+	return issues
+}
+
+// cycle reports the chain of series on the stack from start back to start
+func cycle(stack []*Node, start *Node) Issue {
+	i := len(stack) - 1
+	for i > 0 && stack[i] != start {
+		i--
+	}
+
+	chain := append(append([]*Node{}, stack[i:]...), start)
+	uuids := make([]string, 0, len(chain))
+	labels := make([]string, 0, len(chain))
+	for _, node := range chain {
+		uuids = append(uuids, node.Match.UUID)
+		labels = append(labels, Label(node))
+	}
+	return Issue{
+		Kind:    IssueCycle,
+		Series:  uuids[:len(uuids)-1],
+		Message: "series lead back to themselves: " + strings.Join(labels, " → "),
+	}
+}
+
+// Label names a series by its bracket and name, e.g. "Playoffs: Semifinal 1"
+func Label(node *Node) string {
+	name := strings.TrimSpace(node.Match.Name)
+	if name == "" {
+		name = node.Match.UUID
+	}
+	if node.Bracket == "" {
+		return name
+	}
+	return node.Bracket + ": " + name
+}
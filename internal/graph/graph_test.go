@@ -0,0 +1,112 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func to(series, position string) *domain.BracketDestination {
+	return &domain.BracketDestination{TournamentUUID: "playoffs", SeriesUUID: series, BracketPosition: position}
+}
+
+func team(name string) domain.MatchTeam {
+	return domain.MatchTeam{UUID: name, Name: "Team " + name, Shorthand: name}
+}
+
+// doubleElimination returns a four-team double-elimination bracket in which no series was played yet
+func doubleElimination() domain.Bracket {
+	return domain.Bracket{
+		Index:  1,
+		Label:  "Playoffs",
+		Format: "double-elim-4",
+		Matches: []domain.Match{
+			{UUID: "ub1", Index: 0, Name: "Upper Semifinal 1", Type: "BO5", TeamA: team("A"), TeamB: team("B"), WinnerGoesTo: to("ubf", "A"), LoserGoesTo: to("lb1", "A")},
+			{UUID: "ub2", Index: 1, Name: "Upper Semifinal 2", Type: "BO5", TeamA: team("C"), TeamB: team("D"), WinnerGoesTo: to("ubf", "B"), LoserGoesTo: to("lb1", "B")},
+			{UUID: "ubf", Index: 2, Name: "Upper Final", Type: "BO7", WinnerGoesTo: to("gf", "A"), LoserGoesTo: to("lbf", "A")},
+			{UUID: "lb1", Index: 3, Name: "Lower Round 1", Type: "BO5", WinnerGoesTo: to("lbf", "B")},
+			{UUID: "lbf", Index: 4, Name: "Lower Final", Type: "BO7", WinnerGoesTo: to("gf", "B")},
+			{UUID: "gf", Index: 5, Name: "Grand Final", Type: "BO7"},
+		},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	swiss := domain.Bracket{
+		Index:  0,
+		Label:  "Swiss",
+		Format: "swiss-4",
+		Matches: []domain.Match{
+			{UUID: "s1", Name: "Round 1", TeamA: team("A"), TeamB: team("E"), WinnerGoesTo: to("ub1", "A")},
+		},
+	}
+	g := Build([]domain.Bracket{doubleElimination(), swiss})
+
+	require.Len(t, g.Nodes(), 7)
+	assert.Equal(t, "s1", g.Nodes()[0].Match.UUID)
+	assert.Equal(t, "gf", g.Final().Match.UUID)
+
+	ubf, ok := g.Node("ubf")
+	require.True(t, ok)
+	assert.Equal(t, "gf", ubf.Winner.Match.UUID)
+	assert.Equal(t, "lbf", ubf.Loser.Match.UUID)
+	require.Len(t, ubf.Sources, 2)
+	assert.Equal(t, Edge{From: ubf.Sources[0].From, Position: "A"}, ubf.Sources[0])
+	assert.Equal(t, "ub1", ubf.Sources[0].From.Match.UUID)
+
+	var names []string
+	for _, team := range g.Teams() {
+		names = append(names, team.UUID)
+	}
+	assert.Equal(t, []string{"A", "E", "B", "C", "D"}, names)
+	assert.Empty(t, g.Validate())
+}
+
+func TestBuild_FinalWithThirdPlaceMatch(t *testing.T) {
+	bracket := domain.Bracket{
+		Label:  "Playoffs",
+		Format: "single-elim-4",
+		Matches: []domain.Match{
+			{UUID: "sf1", Index: 0, Name: "Semifinal 1", WinnerGoesTo: to("final", "A"), LoserGoesTo: to("third", "A")},
+			{UUID: "sf2", Index: 1, Name: "Semifinal 2", WinnerGoesTo: to("final", "B"), LoserGoesTo: to("third", "B")},
+			{UUID: "final", Index: 2, Name: "Grand Final"},
+			{UUID: "third", Index: 3, Name: "Third Place"},
+		},
+	}
+	bracket.Matches[1].WinnerGoesTo.BracketPosition = "b"
+
+	g := Build([]domain.Bracket{bracket})
+	assert.Equal(t, "final", g.Final().Match.UUID)
+
+	final, _ := g.Node("final")
+	assert.Equal(t, "B", final.Sources[1].Position)
+}
+
+func TestValidate(t *testing.T) {
+	bracket := domain.Bracket{
+		Label:  "Playoffs",
+		Format: "single-elim-4",
+		Matches: []domain.Match{
+			{UUID: "a", Name: "Round 1", WinnerGoesTo: to("b", "A"), LoserGoesTo: to("missing", "A")},
+			{UUID: "b", Name: "Round 2", WinnerGoesTo: to("c", "A")},
+			{UUID: "c", Name: "Round 3", WinnerGoesTo: to("a", "B")},
+			{UUID: "d", Name: "Round 4", WinnerGoesTo: to("d", "A")},
+		},
+	}
+
+	issues := Build([]domain.Bracket{bracket}).Validate()
+	require.Len(t, issues, 3)
+
+	assert.Equal(t, IssueDangling, issues[0].Kind)
+	assert.Equal(t, []string{"a"}, issues[0].Series)
+	assert.Equal(t, "dangling: loser of Playoffs: Round 1 moves on to series missing, which does not exist", issues[0].String())
+
+	assert.Equal(t, IssueCycle, issues[1].Kind)
+	assert.Equal(t, []string{"a", "b", "c"}, issues[1].Series)
+	assert.Equal(t, "series lead back to themselves: Playoffs: Round 1 → Playoffs: Round 2 → Playoffs: Round 3 → Playoffs: Round 1", issues[1].Message)
+
+	assert.Equal(t, IssueCycle, issues[2].Kind)
+	assert.Equal(t, []string{"d"}, issues[2].Series)
+}
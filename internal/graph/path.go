@@ -0,0 +1,263 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+)
+
+// Status describes whether a team can still win the tournament
+type Status string
+
+const (
+	StatusAlive      Status = "Alive"
+	StatusWinner     Status = "Winner"
+	StatusEliminated Status = "Eliminated"
+)
+
+// Path is the chain of series a team still has to win to win the tournament
+type Path struct {
+	TeamUUID  string
+	Team      string
+	Shorthand string
+	Status    Status
+	// Series is the series the team won the tournament or was eliminated in,
+	// or the last series it played if its path is not linked any further
+	Series string
+	// Steps lists the series the team still has to win, starting with its next series
+	Steps []Step
+	// Complete is true if the steps lead up to the final of the tournament.
+	// Paths through Swiss and round-robin brackets are usually not linked to later series.
+	Complete bool
+}
+
+// Step is a series on the path of a team
+type Step struct {
+	Bracket    string
+	SeriesUUID string
+	Series     string
+	Type       string
+	Time       time.Time
+	Live       bool
+	// TeamScore and OpponentScore hold the current score of a live series
+	TeamScore     int
+	OpponentScore int
+	// Opponent is the opponent of the team, or the series it comes from if it is not known yet
+	Opponent string
+	// IfWon describes where the team moves on to if it wins the series
+	IfWon string
+	// IfLost describes where the team drops to if it loses the series
+	IfLost string
+	// DropsTo is the UUID of the series the team drops to if it loses, empty if it is eliminated
+	DropsTo string
+}
+
+// Path returns the series the team with the given UUID still has to win to win the tournament.
+// The path starts at the next series the team is listed in, or at the series it moves on to
+// after its last completed series.
+func (g *Graph) Path(teamUUID string) (Path, error) {
+	var team domain.MatchTeam
+	var next, last *Node
+	for _, node := range g.order {
+		slot, ok := slotOf(node, teamUUID)
+		if !ok {
+			continue
+		}
+		team = teamIn(node, slot)
+		if !node.Match.IsCompleted {
+			if next == nil {
+				next = node
+			}
+		} else if last == nil || later(node, last) {
+			last = node
+		}
+	}
+	if team.UUID == "" {
+		return Path{}, fmt.Errorf("team %s does not play in the tournament", teamUUID)
+	}
+
+	path := Path{TeamUUID: team.UUID, Team: team.Name, Shorthand: team.Shorthand, Status: StatusAlive}
+	position := ""
+	if next == nil {
+		// Follow the results of the team until it reaches a series that is not completed yet
+		seen := make(map[*Node]bool)
+		for current := last; current != nil && !seen[current]; {
+			seen[current] = true
+			path.Series = Label(current)
+
+			won := wonBy(current, teamUUID)
+			destination, via := current.Winner, current.Match.WinnerGoesTo
+			if !won {
+				destination, via = current.Loser, current.Match.LoserGoesTo
+			}
+
+			switch {
+			case destination == nil && won && current == g.final:
+				path.Status = StatusWinner
+				return path, nil
+			case destination == nil && !won && (!current.grouped || eliminated(current, teamUUID)):
+				path.Status = StatusEliminated
+				return path, nil
+			case destination == nil:
+				// The result is not linked to a later series
+				return path, nil
+			}
+
+			if _, listed := slotOf(destination, teamUUID); !listed || !destination.Match.IsCompleted {
+				next, position = destination, strings.ToUpper(via.BracketPosition)
+				break
+			}
+			current = destination
+		}
+		if next == nil {
+			return path, nil
+		}
+	}
+
+	path.Series = ""
+	seen := make(map[*Node]bool)
+	var previous *Node
+	for node := next; node != nil && !seen[node]; node = node.Winner {
+		seen[node] = true
+		path.Steps = append(path.Steps, g.step(node, teamUUID, position, previous))
+		if node == g.final {
+			path.Complete = true
+		}
+		if node.Match.WinnerGoesTo != nil {
+			position = strings.ToUpper(node.Match.WinnerGoesTo.BracketPosition)
+		}
+		previous = node
+	}
+	return path, nil
+}
+
+// step describes a series on the path of a team that enters it at position from previous
+func (g *Graph) step(node *Node, teamUUID, position string, previous *Node) Step {
+	match := node.Match
+	s := Step{
+		Bracket:    node.Bracket,
+		SeriesUUID: match.UUID,
+		Series:     match.Name,
+		Type:       match.Type,
+		Time:       match.TimeOfSeries,
+		Live:       match.IsLive,
+		Opponent:   g.opponent(node, teamUUID, position, previous),
+	}
+
+	if slot, ok := slotOf(node, teamUUID); ok && match.IsLive {
+		s.TeamScore, s.OpponentScore = match.TeamAScore, match.TeamBScore
+		if slot == "B" {
+			s.TeamScore, s.OpponentScore = s.OpponentScore, s.TeamScore
+		}
+	}
+
+	switch {
+	case node == g.final:
+		s.IfWon = "Wins the tournament"
+	case node.Winner != nil:
+		s.IfWon = Label(node.Winner)
+	default:
+		s.IfWon = "Not linked"
+	}
+
+	switch {
+	case node.Loser != nil:
+		s.IfLost = Label(node.Loser)
+		s.DropsTo = node.Loser.Match.UUID
+	case node.grouped:
+		s.IfLost = "Not linked"
+	default:
+		s.IfLost = "Eliminated"
+	}
+	return s
+}
+
+// opponent names the opponent of a team in a series. If the opponent is not known yet,
+// the series it comes from are named instead, e.g. "Winner of Semifinal 2". Series of
+// other brackets are prefixed with their bracket.
+func (g *Graph) opponent(node *Node, teamUUID, position string, previous *Node) string {
+	match := node.Match
+	slot, listed := slotOf(node, teamUUID)
+	if !listed {
+		slot = position
+	}
+
+	switch {
+	case slot == "A" && match.TeamB.UUID != "":
+		return match.TeamB.Name
+	case slot == "B" && match.TeamA.UUID != "":
+		return match.TeamA.Name
+	case slot == "" && match.TeamA.UUID != "" && match.TeamA.UUID != teamUUID:
+		return match.TeamA.Name
+	case slot == "" && match.TeamB.UUID != "" && match.TeamB.UUID != teamUUID:
+		return match.TeamB.Name
+	}
+
+	var sources []string
+	for _, edge := range node.Sources {
+		if edge.From == previous || (slot != "" && edge.Position != "" && edge.Position == slot) {
+			continue
+		}
+		role := "Winner"
+		if edge.Loser {
+			role = "Loser"
+		}
+		from := Label(edge.From)
+		if edge.From.Bracket == node.Bracket {
+			from = edge.From.Match.Name
+		}
+		sources = append(sources, role+" of "+from)
+	}
+	if len(sources) == 0 {
+		return "TBD"
+	}
+	return strings.Join(sources, " or ")
+}
+
+// slotOf returns the slot (A or B) a team is listed in
+func slotOf(node *Node, teamUUID string) (string, bool) {
+	switch teamUUID {
+	case "":
+		return "", false
+	case node.Match.TeamA.UUID:
+		return "A", true
+	case node.Match.TeamB.UUID:
+		return "B", true
+	}
+	return "", false
+}
+
+func teamIn(node *Node, slot string) domain.MatchTeam {
+	if slot == "B" {
+		return node.Match.TeamB
+	}
+	return node.Match.TeamA
+}
+
+// wonBy returns true if the team won the completed series
+func wonBy(node *Node, teamUUID string) bool {
+	match := node.Match
+	if match.TeamA.UUID == teamUUID {
+		return match.TeamAScore > match.TeamBScore
+	}
+	return match.TeamBScore > match.TeamAScore
+}
+
+// eliminated returns true if the team is flagged as eliminated in the series
+func eliminated(node *Node, teamUUID string) bool {
+	slot, _ := slotOf(node, teamUUID)
+	return teamIn(node, slot).IsEliminated
+}
+
+// later returns true if series a was played after series b
+func later(a, b *Node) bool {
+	if a.position != b.position {
+		return a.position > b.position
+	}
+	if !a.Match.TimeOfSeries.Equal(b.Match.TimeOfSeries) {
+		return a.Match.TimeOfSeries.After(b.Match.TimeOfSeries)
+	}
+	return a.Match.Index > b.Match.Index
+}
@@ -0,0 +1,110 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPath_Upcoming(t *testing.T) {
+	g := Build([]domain.Bracket{doubleElimination()})
+
+	path, err := g.Path("A")
+	require.NoError(t, err)
+	assert.Equal(t, StatusAlive, path.Status)
+	assert.Equal(t, "Team A", path.Team)
+	assert.True(t, path.Complete)
+	require.Len(t, path.Steps, 3)
+
+	assert.Equal(t, Step{
+		Bracket: "Playoffs", SeriesUUID: "ub1", Series: "Upper Semifinal 1", Type: "BO5", Opponent: "Team B",
+		IfWon: "Playoffs: Upper Final", IfLost: "Playoffs: Lower Round 1", DropsTo: "lb1",
+	}, path.Steps[0])
+	assert.Equal(t, "Winner of Upper Semifinal 2", path.Steps[1].Opponent)
+	assert.Equal(t, "Playoffs: Lower Final", path.Steps[1].IfLost)
+	assert.Equal(t, "Winner of Lower Final", path.Steps[2].Opponent)
+	assert.Equal(t, "Wins the tournament", path.Steps[2].IfWon)
+	assert.Equal(t, "Eliminated", path.Steps[2].IfLost)
+	assert.Empty(t, path.Steps[2].DropsTo)
+}
+
+func TestPath_AfterResults(t *testing.T) {
+	bracket := doubleElimination()
+	// A loses to B and drops to the lower bracket, D beats C and leads the upper final
+	bracket.Matches[0].IsCompleted, bracket.Matches[0].TeamAScore, bracket.Matches[0].TeamBScore = true, 1, 3
+	bracket.Matches[1].IsCompleted, bracket.Matches[1].TeamAScore, bracket.Matches[1].TeamBScore = true, 0, 3
+	bracket.Matches[2].TeamA, bracket.Matches[2].TeamB = team("B"), team("D")
+	bracket.Matches[2].IsLive, bracket.Matches[2].TeamAScore, bracket.Matches[2].TeamBScore = true, 1, 2
+	g := Build([]domain.Bracket{bracket})
+
+	t.Run("dropped to the lower bracket", func(t *testing.T) {
+		path, err := g.Path("A")
+		require.NoError(t, err)
+		require.Len(t, path.Steps, 3)
+		assert.Equal(t, "lb1", path.Steps[0].SeriesUUID)
+		assert.Equal(t, "Loser of Upper Semifinal 2", path.Steps[0].Opponent)
+		assert.Equal(t, "Eliminated", path.Steps[0].IfLost)
+		assert.Equal(t, "Loser of Upper Final", path.Steps[1].Opponent)
+		assert.Equal(t, "Winner of Upper Final", path.Steps[2].Opponent)
+	})
+
+	t.Run("live series", func(t *testing.T) {
+		path, err := g.Path("D")
+		require.NoError(t, err)
+		require.Len(t, path.Steps, 2)
+		assert.True(t, path.Steps[0].Live)
+		assert.Equal(t, "Team B", path.Steps[0].Opponent)
+		assert.Equal(t, 2, path.Steps[0].TeamScore)
+		assert.Equal(t, 1, path.Steps[0].OpponentScore)
+	})
+
+	t.Run("unknown team", func(t *testing.T) {
+		_, err := g.Path("Z")
+		assert.EqualError(t, err, "team Z does not play in the tournament")
+	})
+}
+
+func TestPath_Finished(t *testing.T) {
+	bracket := domain.Bracket{
+		Label:  "Playoffs",
+		Format: "single-elim-2",
+		Matches: []domain.Match{
+			{UUID: "sf", Index: 0, Name: "Semifinal", TeamA: team("A"), TeamB: team("B"), TeamAScore: 3, TeamBScore: 1, IsCompleted: true, WinnerGoesTo: to("final", "A")},
+			{UUID: "final", Index: 1, Name: "Grand Final", TeamA: team("A"), TeamB: team("C"), TeamAScore: 4, TeamBScore: 2, IsCompleted: true},
+		},
+	}
+	g := Build([]domain.Bracket{bracket})
+
+	path, err := g.Path("A")
+	require.NoError(t, err)
+	assert.Equal(t, StatusWinner, path.Status)
+	assert.Equal(t, "Playoffs: Grand Final", path.Series)
+	assert.Empty(t, path.Steps)
+
+	path, err = g.Path("B")
+	require.NoError(t, err)
+	assert.Equal(t, StatusEliminated, path.Status)
+	assert.Equal(t, "Playoffs: Semifinal", path.Series)
+}
+
+func TestPath_NotLinked(t *testing.T) {
+	swiss := domain.Bracket{
+		Label:  "Swiss",
+		Format: "swiss-4",
+		Matches: []domain.Match{
+			{UUID: "s1", Name: "Round 1", TeamA: team("A"), TeamB: team("B"), TeamAScore: 3, TeamBScore: 0, IsCompleted: true},
+		},
+	}
+	g := Build([]domain.Bracket{swiss})
+
+	for _, id := range []string{"A", "B"} {
+		path, err := g.Path(id)
+		require.NoError(t, err)
+		assert.Equal(t, StatusAlive, path.Status)
+		assert.Equal(t, "Swiss: Round 1", path.Series)
+		assert.Empty(t, path.Steps)
+		assert.False(t, path.Complete)
+	}
+}
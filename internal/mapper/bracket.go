@@ -2,22 +2,26 @@ package mapper
 
 import (
 	"fmt"
-	"time"
+	"log/slog"
 
 	"github.com/mgranderath/rlcs-cli/internal/api/blast"
 	"github.com/mgranderath/rlcs-cli/internal/domain"
 )
 
-const timeFormat = "2006-01-02T15:04:05.000Z"
-
 // ToDomainBracket converts a Blast API bracket to the domain model
 func ToDomainBracket(api blast.Bracket) (domain.Bracket, error) {
-	startDate, err := time.Parse(timeFormat, api.StartDate)
+	return toDomainBracket(api, nil)
+}
+
+// toDomainBracket converts a bracket. Matches that cannot be mapped are added to
+// result and skipped if it is non-nil, otherwise they fail the whole bracket.
+func toDomainBracket(api blast.Bracket, result *Result[domain.Bracket]) (domain.Bracket, error) {
+	startDate, err := parseTime(api.StartDate)
 	if err != nil {
 		return domain.Bracket{}, fmt.Errorf("failed to parse start date: %w", err)
 	}
 
-	endDate, err := time.Parse(timeFormat, api.EndDate)
+	endDate, err := parseTime(api.EndDate)
 	if err != nil {
 		return domain.Bracket{}, fmt.Errorf("failed to parse end date: %w", err)
 	}
@@ -25,6 +29,10 @@ func ToDomainBracket(api blast.Bracket) (domain.Bracket, error) {
 	matches := make([]domain.Match, 0, len(api.Matches))
 	for _, apiMatch := range api.Matches {
 		match, err := ToDomainMatch(apiMatch)
+		if err != nil && result != nil {
+			result.skip("match", apiMatch.UUID, err)
+			continue
+		}
 		if err != nil {
 			return domain.Bracket{}, fmt.Errorf("failed to map match %s: %w", apiMatch.UUID, err)
 		}
@@ -49,7 +57,7 @@ func ToDomainBracket(api blast.Bracket) (domain.Bracket, error) {
 
 // ToDomainMatch converts a Blast API match to the domain model
 func ToDomainMatch(api blast.Match) (domain.Match, error) {
-	timeOfSeries, err := time.Parse(timeFormat, api.TimeOfSeries)
+	timeOfSeries, err := parseTime(api.TimeOfSeries)
 	if err != nil {
 		return domain.Match{}, fmt.Errorf("failed to parse time of series: %w", err)
 	}
@@ -114,28 +122,20 @@ func ToDomainMatch(api blast.Match) (domain.Match, error) {
 
 // ToDomainMap converts a Blast API map to the domain model
 func ToDomainMap(api blast.Map) (domain.MatchMap, error) {
-	scheduledStart, err := time.Parse(timeFormat, api.ScheduledStartTime)
+	scheduledStart, err := parseTime(api.ScheduledStartTime)
 	if err != nil {
 		return domain.MatchMap{}, fmt.Errorf("failed to parse scheduled start time: %w", err)
 	}
 
-	// Handle empty time strings for fields that may not be set (e.g., upcoming matches)
-	var actualStart time.Time
-	if api.ActualStartTime != "" {
-		parsedTime, err := time.Parse(timeFormat, api.ActualStartTime)
-		if err != nil {
-			return domain.MatchMap{}, fmt.Errorf("failed to parse actual start time: %w", err)
-		}
-		actualStart = parsedTime
+	// Actual start and end times are not set for maps that have not been played
+	actualStart, err := parseOptionalTime(api.ActualStartTime)
+	if err != nil {
+		return domain.MatchMap{}, fmt.Errorf("failed to parse actual start time: %w", err)
 	}
 
-	var matchEnded time.Time
-	if api.MatchEndedTime != "" {
-		parsedTime, err := time.Parse(timeFormat, api.MatchEndedTime)
-		if err != nil {
-			return domain.MatchMap{}, fmt.Errorf("failed to parse match ended time: %w", err)
-		}
-		matchEnded = parsedTime
+	matchEnded, err := parseOptionalTime(api.MatchEndedTime)
+	if err != nil {
+		return domain.MatchMap{}, fmt.Errorf("failed to parse match ended time: %w", err)
 	}
 
 	return domain.MatchMap{
@@ -150,17 +150,21 @@ func ToDomainMap(api blast.Map) (domain.MatchMap, error) {
 	}, nil
 }
 
-// ToDomainBrackets converts a slice of Blast API brackets to domain models
-func ToDomainBrackets(apiBrackets []blast.Bracket) ([]domain.Bracket, error) {
-	result := make([]domain.Bracket, 0, len(apiBrackets))
+// ToDomainBrackets converts a slice of Blast API brackets to domain models.
+// Brackets and matches that cannot be mapped are skipped and reported in the result.
+func ToDomainBrackets(apiBrackets []blast.Bracket) Result[domain.Bracket] {
+	result := Result[domain.Bracket]{Items: make([]domain.Bracket, 0, len(apiBrackets))}
 
 	for _, api := range apiBrackets {
-		bracket, err := ToDomainBracket(api)
+		bracket, err := toDomainBracket(api, &result)
 		if err != nil {
-			return nil, fmt.Errorf("failed to map bracket %s: %w", api.TournamentUUID, err)
+			result.skip("bracket", api.TournamentUUID, err)
+			continue
 		}
-		result = append(result, bracket)
+		result.Items = append(result.Items, bracket)
 	}
 
-	return result, nil
+	slog.Debug("mapped brackets", "count", len(result.Items), "skipped", len(result.Skipped))
+
+	return result
 }
@@ -260,8 +260,9 @@ func TestToDomainBrackets(t *testing.T) {
 	tests := []struct {
 		name        string
 		api         []blast.Bracket
-		expectError bool
 		expectedLen int
+		// expectedSkipped lists the IDs of the records that cannot be mapped
+		expectedSkipped []string
 	}{
 		{
 			name: "multiple brackets",
@@ -281,13 +282,11 @@ func TestToDomainBrackets(t *testing.T) {
 					Matches:        []blast.Match{},
 				},
 			},
-			expectError: false,
 			expectedLen: 2,
 		},
 		{
 			name:        "empty brackets",
 			api:         []blast.Bracket{},
-			expectError: false,
 			expectedLen: 0,
 		},
 		{
@@ -306,21 +305,57 @@ func TestToDomainBrackets(t *testing.T) {
 					Matches:        []blast.Match{},
 				},
 			},
-			expectError: true,
+			expectedLen:     1,
+			expectedSkipped: []string{"bracket-2"},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := ToDomainBrackets(tt.api)
+			result := ToDomainBrackets(tt.api)
 
-			if tt.expectError {
-				assert.Error(t, err)
-				return
+			assert.Len(t, result.Items, tt.expectedLen)
+			skipped := make([]string, 0, len(result.Skipped))
+			for _, err := range result.Skipped {
+				skipped = append(skipped, err.ID)
+			}
+			assert.ElementsMatch(t, tt.expectedSkipped, skipped)
+			if len(tt.expectedSkipped) == 0 {
+				assert.NoError(t, result.Err())
+			} else {
+				assert.Error(t, result.Err())
 			}
-
-			require.NoError(t, err)
-			assert.Len(t, result, tt.expectedLen)
 		})
 	}
 }
+
+func TestToDomainBrackets_SkipsInvalidMatches(t *testing.T) {
+	result := ToDomainBrackets([]blast.Bracket{
+		{
+			TournamentUUID: "bracket-1",
+			StartDate:      "2026-01-15T10:00:00.000Z",
+			EndDate:        "2026-01-17T18:00:00.000Z",
+			Matches: []blast.Match{
+				{UUID: "match-1", TimeOfSeries: "2026-01-15T12:00:00Z"},
+				{UUID: "match-2", TimeOfSeries: "tbd"},
+			},
+		},
+	})
+
+	require.Len(t, result.Items, 1)
+	require.Len(t, result.Items[0].Matches, 1)
+	assert.Equal(t, "match-1", result.Items[0].Matches[0].UUID)
+
+	require.Len(t, result.Skipped, 1)
+	assert.Equal(t, "match", result.Skipped[0].Kind)
+	assert.Equal(t, "match-2", result.Skipped[0].ID)
+	assert.EqualError(t, result.Err(), `failed to map match match-2: failed to parse time of series: unrecognized timestamp "tbd"`)
+
+	_, err := ToDomainBracket(blast.Bracket{
+		TournamentUUID: "bracket-1",
+		StartDate:      "2026-01-15T10:00:00.000Z",
+		EndDate:        "2026-01-17T18:00:00.000Z",
+		Matches:        []blast.Match{{UUID: "match-2", TimeOfSeries: "tbd"}},
+	})
+	assert.ErrorContains(t, err, "failed to map match match-2")
+}
@@ -2,29 +2,33 @@ package mapper
 
 import (
 	"fmt"
-	"time"
+	"log/slog"
 
 	"github.com/mgranderath/rlcs-cli/internal/api/blast"
 	"github.com/mgranderath/rlcs-cli/internal/domain"
 )
 
-// ToDomainMatchesFromResponse converts API match responses to domain matches
-func ToDomainMatchesFromResponse(apiMatches []blast.MatchResponse) ([]domain.Match, error) {
-	result := make([]domain.Match, 0, len(apiMatches))
+// ToDomainMatchesFromResponse converts API match responses to domain matches.
+// Matches that cannot be mapped are skipped and reported in the result.
+func ToDomainMatchesFromResponse(apiMatches []blast.MatchResponse) Result[domain.Match] {
+	result := Result[domain.Match]{Items: make([]domain.Match, 0, len(apiMatches))}
 
 	for _, apiMatch := range apiMatches {
 		match, err := toDomainMatchFromResponse(apiMatch)
 		if err != nil {
-			return nil, fmt.Errorf("failed to map match %s: %w", apiMatch.ID, err)
+			result.skip("match", apiMatch.ID, err)
+			continue
 		}
-		result = append(result, match)
+		result.Items = append(result.Items, match)
 	}
 
-	return result, nil
+	slog.Debug("mapped matches", "count", len(result.Items), "skipped", len(result.Skipped))
+
+	return result
 }
 
 func toDomainMatchFromResponse(api blast.MatchResponse) (domain.Match, error) {
-	timeOfSeries, err := time.Parse(timeFormat, api.ScheduledAt)
+	timeOfSeries, err := parseTime(api.ScheduledAt)
 	if err != nil {
 		return domain.Match{}, fmt.Errorf("failed to parse scheduled time: %w", err)
 	}
@@ -69,28 +73,20 @@ func toDomainMatchFromResponse(api blast.MatchResponse) (domain.Match, error) {
 }
 
 func toDomainMatchMapFromResponse(api blast.MatchResponseMap) (domain.MatchMap, error) {
-	scheduledStart, err := time.Parse(timeFormat, api.ScheduledAt)
+	scheduledStart, err := parseTime(api.ScheduledAt)
 	if err != nil {
 		return domain.MatchMap{}, fmt.Errorf("failed to parse scheduled start time: %w", err)
 	}
 
-	// Handle empty time strings for fields that may not be set
-	var actualStart time.Time
-	if api.StartedAt != "" {
-		parsedTime, err := time.Parse(timeFormat, api.StartedAt)
-		if err != nil {
-			return domain.MatchMap{}, fmt.Errorf("failed to parse started at time: %w", err)
-		}
-		actualStart = parsedTime
+	// Start and end times are not set for maps that have not been played
+	actualStart, err := parseOptionalTime(api.StartedAt)
+	if err != nil {
+		return domain.MatchMap{}, fmt.Errorf("failed to parse started at time: %w", err)
 	}
 
-	var matchEnded time.Time
-	if api.EndedAt != "" {
-		parsedTime, err := time.Parse(timeFormat, api.EndedAt)
-		if err != nil {
-			return domain.MatchMap{}, fmt.Errorf("failed to parse ended at time: %w", err)
-		}
-		matchEnded = parsedTime
+	matchEnded, err := parseOptionalTime(api.EndedAt)
+	if err != nil {
+		return domain.MatchMap{}, fmt.Errorf("failed to parse ended at time: %w", err)
 	}
 
 	return domain.MatchMap{
@@ -13,8 +13,9 @@ func TestToDomainMatchesFromResponse(t *testing.T) {
 	tests := []struct {
 		name        string
 		api         []blast.MatchResponse
-		expectError bool
 		expectedLen int
+		// expectedSkipped lists the IDs of the records that cannot be mapped
+		expectedSkipped []string
 	}{
 		{
 			name: "valid matches",
@@ -60,13 +61,11 @@ func TestToDomainMatchesFromResponse(t *testing.T) {
 					Maps:       []blast.MatchResponseMap{},
 				},
 			},
-			expectError: false,
 			expectedLen: 2,
 		},
 		{
 			name:        "empty matches",
 			api:         []blast.MatchResponse{},
-			expectError: false,
 			expectedLen: 0,
 		},
 		{
@@ -91,25 +90,30 @@ func TestToDomainMatchesFromResponse(t *testing.T) {
 					Maps:        []blast.MatchResponseMap{},
 				},
 			},
-			expectError: true,
+			expectedLen:     1,
+			expectedSkipped: []string{"match-2"},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := ToDomainMatchesFromResponse(tt.api)
+			result := ToDomainMatchesFromResponse(tt.api)
 
-			if tt.expectError {
-				assert.Error(t, err)
-				return
+			assert.Len(t, result.Items, tt.expectedLen)
+			skipped := make([]string, 0, len(result.Skipped))
+			for _, err := range result.Skipped {
+				skipped = append(skipped, err.ID)
+			}
+			assert.ElementsMatch(t, tt.expectedSkipped, skipped)
+			if len(tt.expectedSkipped) == 0 {
+				assert.NoError(t, result.Err())
+			} else {
+				assert.Error(t, result.Err())
 			}
-
-			require.NoError(t, err)
-			assert.Len(t, result, tt.expectedLen)
 
 			if tt.expectedLen > 0 {
-				assert.Equal(t, tt.api[0].ID, result[0].UUID)
-				assert.Equal(t, tt.api[0].Name, result[0].Name)
+				assert.Equal(t, tt.api[0].ID, result.Items[0].UUID)
+				assert.Equal(t, tt.api[0].Name, result.Items[0].Name)
 			}
 		})
 	}
@@ -0,0 +1,39 @@
+package mapper
+
+import "fmt"
+
+// RecordError describes a single API record that could not be mapped
+type RecordError struct {
+	// Kind is the type of record (e.g., "match", "bracket", "tournament")
+	Kind string
+	// ID identifies the record in the API response
+	ID  string
+	Err error
+}
+
+func (e *RecordError) Error() string {
+	return fmt.Sprintf("failed to map %s %s: %v", e.Kind, e.ID, e.Err)
+}
+
+func (e *RecordError) Unwrap() error {
+	return e.Err
+}
+
+// Result holds the records of a list that were mapped successfully
+// along with the errors of all records that were skipped
+type Result[T any] struct {
+	Items   []T
+	Skipped []*RecordError
+}
+
+// Err returns the error of the first skipped record, nil if all records were mapped
+func (r Result[T]) Err() error {
+	if len(r.Skipped) == 0 {
+		return nil
+	}
+	return r.Skipped[0]
+}
+
+func (r *Result[T]) skip(kind, id string, err error) {
+	r.Skipped = append(r.Skipped, &RecordError{Kind: kind, ID: id, Err: err})
+}
@@ -0,0 +1,34 @@
+package mapper
+
+import (
+	"fmt"
+	"time"
+)
+
+// timeLayouts are the timestamp layouts accepted from the API, in order of preference.
+// RFC3339 parsing accepts timestamps with and without fractional seconds.
+var timeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// parseTime parses a timestamp in any of the accepted layouts.
+// Timestamps without a zone are interpreted as UTC.
+func parseTime(value string) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized timestamp %q", value)
+}
+
+// parseOptionalTime parses a timestamp that may not be set yet (e.g., for upcoming matches)
+func parseOptionalTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return parseTime(value)
+}
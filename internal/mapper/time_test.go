@@ -0,0 +1,61 @@
+package mapper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Time
+	}{
+		{
+			name:     "RFC3339 with millis",
+			value:    "2026-01-15T18:00:00.000Z",
+			expected: time.Date(2026, 1, 15, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "RFC3339 without millis",
+			value:    "2026-01-15T18:00:00Z",
+			expected: time.Date(2026, 1, 15, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "RFC3339 with offset",
+			value:    "2026-01-15T19:00:00+01:00",
+			expected: time.Date(2026, 1, 15, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "without zone",
+			value:    "2026-01-15T18:00:00",
+			expected: time.Date(2026, 1, 15, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "date only",
+			value:    "2026-01-15",
+			expected: time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := parseTime(tt.value)
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(result), "expected %s, got %s", tt.expected, result)
+		})
+	}
+
+	_, err := parseTime("15/01/2026")
+	assert.EqualError(t, err, `unrecognized timestamp "15/01/2026"`)
+
+	_, err = parseTime("")
+	assert.Error(t, err)
+
+	optional, err := parseOptionalTime("")
+	require.NoError(t, err)
+	assert.True(t, optional.IsZero())
+}
@@ -2,23 +2,21 @@ package mapper
 
 import (
 	"fmt"
+	"log/slog"
 	"strings"
-	"time"
 
 	"github.com/mgranderath/rlcs-cli/internal/api/blast"
 	"github.com/mgranderath/rlcs-cli/internal/domain"
 )
 
-const dateFormat = "2006-01-02"
-
 // ToDomainTournament converts a Blast API tournament to the domain model
 func ToDomainTournament(api blast.Tournament) (domain.Tournament, error) {
-	startDate, err := time.Parse(dateFormat, api.StartDate)
+	startDate, err := parseTime(api.StartDate)
 	if err != nil {
 		return domain.Tournament{}, fmt.Errorf("failed to parse start date: %w", err)
 	}
 
-	endDate, err := time.Parse(dateFormat, api.EndDate)
+	endDate, err := parseTime(api.EndDate)
 	if err != nil {
 		return domain.Tournament{}, fmt.Errorf("failed to parse end date: %w", err)
 	}
@@ -44,19 +42,23 @@ func ToDomainTournament(api blast.Tournament) (domain.Tournament, error) {
 	}, nil
 }
 
-// ToDomainTournaments converts a slice of Blast API tournaments to domain models
-func ToDomainTournaments(apiTournaments []blast.Tournament) ([]domain.Tournament, error) {
-	result := make([]domain.Tournament, 0, len(apiTournaments))
+// ToDomainTournaments converts a slice of Blast API tournaments to domain models.
+// Tournaments that cannot be mapped are skipped and reported in the result.
+func ToDomainTournaments(apiTournaments []blast.Tournament) Result[domain.Tournament] {
+	result := Result[domain.Tournament]{Items: make([]domain.Tournament, 0, len(apiTournaments))}
 
 	for _, api := range apiTournaments {
-		domain, err := ToDomainTournament(api)
+		tournament, err := ToDomainTournament(api)
 		if err != nil {
-			return nil, fmt.Errorf("failed to map tournament %s: %w", api.ID, err)
+			result.skip("tournament", api.ID, err)
+			continue
 		}
-		result = append(result, domain)
+		result.Items = append(result.Items, tournament)
 	}
 
-	return result, nil
+	slog.Debug("mapped tournaments", "count", len(result.Items), "skipped", len(result.Skipped))
+
+	return result
 }
 
 // parseRegion converts API region string to domain Region type
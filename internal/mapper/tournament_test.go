@@ -141,8 +141,9 @@ func TestToDomainTournaments(t *testing.T) {
 	tests := []struct {
 		name        string
 		api         []blast.Tournament
-		expectError bool
 		expectedLen int
+		// expectedSkipped lists the IDs of the records that cannot be mapped
+		expectedSkipped []string
 	}{
 		{
 			name: "multiple tournaments",
@@ -150,13 +151,11 @@ func TestToDomainTournaments(t *testing.T) {
 				{ID: "1", Name: "T1", StartDate: "2026-01-01", EndDate: "2026-01-02", Region: "NA"},
 				{ID: "2", Name: "T2", StartDate: "2026-01-03", EndDate: "2026-01-04", Region: "EU"},
 			},
-			expectError: false,
 			expectedLen: 2,
 		},
 		{
 			name:        "empty slice",
 			api:         []blast.Tournament{},
-			expectError: false,
 			expectedLen: 0,
 		},
 		{
@@ -165,21 +164,26 @@ func TestToDomainTournaments(t *testing.T) {
 				{ID: "1", Name: "T1", StartDate: "2026-01-01", EndDate: "2026-01-02"},
 				{ID: "2", Name: "T2", StartDate: "invalid", EndDate: "2026-01-04"},
 			},
-			expectError: true,
+			expectedLen:     1,
+			expectedSkipped: []string{"2"},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := ToDomainTournaments(tt.api)
+			result := ToDomainTournaments(tt.api)
 
-			if tt.expectError {
-				assert.Error(t, err)
-				return
+			assert.Len(t, result.Items, tt.expectedLen)
+			skipped := make([]string, 0, len(result.Skipped))
+			for _, err := range result.Skipped {
+				skipped = append(skipped, err.ID)
+			}
+			assert.ElementsMatch(t, tt.expectedSkipped, skipped)
+			if len(tt.expectedSkipped) == 0 {
+				assert.NoError(t, result.Err())
+			} else {
+				assert.Error(t, result.Err())
 			}
-
-			require.NoError(t, err)
-			assert.Len(t, result, tt.expectedLen)
 		})
 	}
 }
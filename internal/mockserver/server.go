@@ -0,0 +1,220 @@
+// Package mockserver serves the Blast API endpoints used by the CLI from fixture files
+package mockserver
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ScenarioFile is the name of the optional scenario in a fixtures directory
+const ScenarioFile = "scenario.yaml"
+
+// Scenario describes how the served fixtures change over time.
+// Each step overlays its directory on top of the base fixtures directory.
+type Scenario struct {
+	Steps []Step `yaml:"steps"`
+}
+
+// Step is a set of fixtures that becomes active after a delay
+type Step struct {
+	// After is the time since server start at which the step becomes active
+	After time.Duration `yaml:"after"`
+	// Dir is the fixtures directory of the step, relative to the base directory
+	Dir string `yaml:"dir"`
+}
+
+// LoadScenario reads a scenario file
+func LoadScenario(path string) (*Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scenario: %w", err)
+	}
+
+	var scenario Scenario
+	if err := yaml.Unmarshal(data, &scenario); err != nil {
+		return nil, fmt.Errorf("failed to parse scenario: %w", err)
+	}
+	for i, step := range scenario.Steps {
+		if !validStepDir(step.Dir) {
+			return nil, fmt.Errorf("scenario step %d: dir %q must be a directory inside the fixtures directory", i+1, step.Dir)
+		}
+		if i > 0 && step.After < scenario.Steps[i-1].After {
+			return nil, fmt.Errorf("scenario step %d starts before step %d", i+1, i)
+		}
+	}
+	return &scenario, nil
+}
+
+// Server serves fixture files using the Blast API URL layout:
+//
+//	circuits/{circuit}/tournaments.json  -> GET /circuits/{circuit}/tournaments
+//	tournaments/{id}/matches.json        -> GET /games/rl/tournaments/{id}/matches
+//	tournaments/{id}/brackets.json       -> GET /games/rl/tournaments/{id}/brackets
+//	matches/{id}.json                    -> GET /matches/{id}/detailed
+//
+// POST /_mock/advance activates the next scenario step immediately.
+type Server struct {
+	dir      string
+	scenario *Scenario
+	// speed scales the passing of time for scenario steps
+	speed float64
+
+	mu     sync.Mutex
+	start  time.Time
+	forced int
+
+	// now is a function that returns the current time, can be overridden for testing
+	now func() time.Time
+}
+
+// New creates a server for a fixtures directory. The scenario may be nil.
+func New(dir string, scenario *Scenario, speed float64) *Server {
+	if speed <= 0 {
+		speed = 1
+	}
+	s := &Server{
+		dir:      dir,
+		scenario: scenario,
+		speed:    speed,
+		now:      time.Now,
+	}
+	s.start = s.now()
+	return s
+}
+
+// Handler returns the HTTP handler of the server
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /circuits/{circuit}/tournaments", func(w http.ResponseWriter, r *http.Request) {
+		s.serveFixture(w, r, "circuits", r.PathValue("circuit"), "tournaments.json")
+	})
+	mux.HandleFunc("GET /games/rl/tournaments/{id}/matches", func(w http.ResponseWriter, r *http.Request) {
+		s.serveFixture(w, r, "tournaments", r.PathValue("id"), "matches.json")
+	})
+	mux.HandleFunc("GET /games/rl/tournaments/{id}/brackets", func(w http.ResponseWriter, r *http.Request) {
+		s.serveFixture(w, r, "tournaments", r.PathValue("id"), "brackets.json")
+	})
+	mux.HandleFunc("GET /matches/{id}/detailed", func(w http.ResponseWriter, r *http.Request) {
+		s.serveFixture(w, r, "matches", r.PathValue("id")+".json")
+	})
+	mux.HandleFunc("POST /_mock/advance", func(w http.ResponseWriter, r *http.Request) {
+		step := s.Advance()
+		fmt.Fprintf(w, "{\"step\":%d}\n", step)
+	})
+	return mux
+}
+
+// Step returns the index of the active scenario step, -1 without a scenario
+func (s *Server) Step() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.step()
+}
+
+// Advance activates the next scenario step and returns its index
+func (s *Server) Advance() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	step := s.step()
+	if s.scenario != nil && step < len(s.scenario.Steps)-1 {
+		s.forced = step + 1
+	}
+	return s.step()
+}
+
+func (s *Server) step() int {
+	if s.scenario == nil || len(s.scenario.Steps) == 0 {
+		return -1
+	}
+
+	elapsed := time.Duration(float64(s.now().Sub(s.start)) * s.speed)
+	step := 0
+	for i, st := range s.scenario.Steps {
+		if st.After <= elapsed {
+			step = i
+		}
+	}
+	if s.forced > step {
+		step = s.forced
+	}
+	return step
+}
+
+// resolve returns the path of a fixture, preferring the active scenario step
+func (s *Server) resolve(elem ...string) (string, error) {
+	for _, e := range elem {
+		if !validElement(e) {
+			return "", fs.ErrNotExist
+		}
+	}
+
+	if step := s.Step(); step >= 0 && validStepDir(s.scenario.Steps[step].Dir) {
+		path := filepath.Join(append([]string{s.dir, s.scenario.Steps[step].Dir}, elem...)...)
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+
+	path := filepath.Join(append([]string{s.dir}, elem...)...)
+	if _, err := os.Stat(path); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// validElement reports whether e is a single file or directory name, so it cannot leave its parent directory
+func validElement(e string) bool {
+	return e != "" && e != "." && e != ".." && filepath.Base(e) == e
+}
+
+// validStepDir reports whether the directory of a scenario step stays inside the fixtures directory.
+// It is either empty or "." for the fixtures directory itself, or slash-separated elements that are valid on their own.
+func validStepDir(dir string) bool {
+	if dir == "" || dir == "." {
+		return true
+	}
+	for _, e := range strings.Split(dir, "/") {
+		if !validElement(e) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) serveFixture(w http.ResponseWriter, r *http.Request, elem ...string) {
+	path, err := s.resolve(elem...)
+	if errors.Is(err, fs.ErrNotExist) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintln(w, `{"message":"not found"}`)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sum := sha256.Sum256(data)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:8])+`"`)
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(data))
+}
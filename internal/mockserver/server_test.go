@@ -0,0 +1,129 @@
+package mockserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/api/blast"
+	"github.com/mgranderath/rlcs-cli/internal/mapper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_Scenario(t *testing.T) {
+	scenario, err := LoadScenario("testdata/series/scenario.yaml")
+	require.NoError(t, err)
+	require.Len(t, scenario.Steps, 3)
+
+	now := time.Date(2026, 1, 12, 17, 55, 0, 0, time.UTC)
+	server := New("testdata/series", scenario, 1)
+	server.now = func() time.Time { return now }
+	server.start = now
+
+	httpServer := httptest.NewServer(server.Handler())
+	defer httpServer.Close()
+
+	client := blast.NewClient(blast.WithBaseURL(httpServer.URL), blast.WithRetry(0, 0))
+	ctx := context.Background()
+
+	tournaments, err := client.ListTournaments(ctx, "2026")
+	require.NoError(t, err)
+	require.Len(t, tournaments, 1)
+	assert.Equal(t, "t1", tournaments[0].ID)
+
+	matchStatus := func() (bool, bool, int, int) {
+		apiMatches, err := client.TournamentMatches(ctx, "t1")
+		require.NoError(t, err)
+		result := mapper.ToDomainMatchesFromResponse(apiMatches)
+		require.NoError(t, result.Err())
+		matches := result.Items
+		require.Len(t, matches, 1)
+		return matches[0].IsLive, matches[0].IsCompleted, matches[0].TeamAScore, matches[0].TeamBScore
+	}
+
+	// Scheduled
+	isLive, isCompleted, _, _ := matchStatus()
+	assert.False(t, isLive)
+	assert.False(t, isCompleted)
+
+	// Live after 5 minutes
+	now = now.Add(6 * time.Minute)
+	isLive, isCompleted, scoreA, scoreB := matchStatus()
+	assert.True(t, isLive)
+	assert.False(t, isCompleted)
+	assert.Equal(t, 1, scoreA)
+	assert.Equal(t, 0, scoreB)
+
+	// Completed once advanced manually
+	resp, err := http.Post(httpServer.URL+"/_mock/advance", "application/json", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, 2, server.Step())
+
+	isLive, isCompleted, scoreA, scoreB = matchStatus()
+	assert.False(t, isLive)
+	assert.True(t, isCompleted)
+	assert.Equal(t, 3, scoreA)
+	assert.Equal(t, 0, scoreB)
+
+	match, err := client.MatchDetail(ctx, "m1")
+	require.NoError(t, err)
+	assert.Equal(t, 3, match.TeamAScore)
+}
+
+func TestServer_NotFound(t *testing.T) {
+	httpServer := httptest.NewServer(New("testdata/series", nil, 1).Handler())
+	defer httpServer.Close()
+
+	client := blast.NewClient(blast.WithBaseURL(httpServer.URL), blast.WithRetry(0, 0))
+
+	_, err := client.TournamentBrackets(context.Background(), "t1")
+	assert.ErrorIs(t, err, blast.ErrNotFound)
+
+	_, err = client.MatchDetail(context.Background(), "..")
+	assert.ErrorIs(t, err, blast.ErrNotFound)
+
+	// Step directories cannot leave the fixtures directory either
+	escape := &Scenario{Steps: []Step{{Dir: ".."}}}
+	escapeServer := httptest.NewServer(New("testdata/series/live", escape, 1).Handler())
+	defer escapeServer.Close()
+
+	client = blast.NewClient(blast.WithBaseURL(escapeServer.URL), blast.WithRetry(0, 0))
+	_, err = client.ListTournaments(context.Background(), "2026")
+	assert.ErrorIs(t, err, blast.ErrNotFound)
+}
+
+func TestLoadScenario_InvalidDir(t *testing.T) {
+	for _, dir := range []string{"..", "../..", "live/../..", "/etc"} {
+		path := filepath.Join(t.TempDir(), "scenario.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(fmt.Sprintf("steps:\n  - after: 0s\n    dir: %q\n", dir)), 0o644))
+
+		_, err := LoadScenario(path)
+		assert.EqualError(t, err, fmt.Sprintf("scenario step 1: dir %q must be a directory inside the fixtures directory", dir), dir)
+	}
+}
+
+func TestServer_ETag(t *testing.T) {
+	httpServer := httptest.NewServer(New("testdata/series", nil, 1).Handler())
+	defer httpServer.Close()
+
+	resp, err := http.Get(httpServer.URL + "/circuits/2026/tournaments")
+	require.NoError(t, err)
+	resp.Body.Close()
+	etag := resp.Header.Get("ETag")
+	require.NotEmpty(t, etag)
+
+	req, err := http.NewRequest(http.MethodGet, httpServer.URL+"/circuits/2026/tournaments", nil)
+	require.NoError(t, err)
+	req.Header.Set("If-None-Match", etag)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotModified, resp.StatusCode)
+}
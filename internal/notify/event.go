@@ -0,0 +1,243 @@
+// Package notify detects match lifecycle events from successive fetches and posts them to webhooks
+package notify
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/ratings"
+)
+
+// DefaultUpsetProbability is the win probability below which a series win counts as an upset
+const DefaultUpsetProbability = 0.35
+
+// Kind identifies a lifecycle event of a series
+type Kind string
+
+const (
+	KindScheduled   Kind = "series_scheduled"
+	KindRescheduled Kind = "series_rescheduled"
+	KindStarted     Kind = "series_started"
+	KindGameEnded   Kind = "game_ended"
+	KindSeriesWon   Kind = "series_won"
+	KindUpset       Kind = "upset"
+)
+
+// Kinds lists all event kinds in the order they happen
+var Kinds = []Kind{KindScheduled, KindRescheduled, KindStarted, KindGameEnded, KindSeriesWon, KindUpset}
+
+// Event is a lifecycle event of a series
+type Event struct {
+	// ID identifies the event across runs, an event with the same ID is only sent once
+	ID             string
+	Kind           Kind
+	TournamentID   string
+	TournamentName string
+	MatchUUID      string
+	Stage          string
+	Type           string
+	ScheduledAt    time.Time
+	// PreviousScheduledAt is the time the series was scheduled at before it was rescheduled
+	PreviousScheduledAt time.Time
+	TeamA               domain.MatchTeam
+	TeamB               domain.MatchTeam
+	TeamAScore          int
+	TeamBScore          int
+	// Game is the name of the game that ended, GameTeamAScore and GameTeamBScore are its goals
+	Game           string
+	GameTeamAScore int
+	GameTeamBScore int
+	// Winner and Loser are set for series that are over
+	Winner string
+	Loser  string
+	// WinProbability is the chance the winner of an upset had before the series
+	WinProbability float64
+}
+
+// Series names the series by its teams
+func (e Event) Series() string {
+	return teamName(e.TeamA) + " vs " + teamName(e.TeamB)
+}
+
+// Title is a short headline of the event
+func (e Event) Title() string {
+	switch e.Kind {
+	case KindScheduled:
+		return "Series scheduled"
+	case KindRescheduled:
+		return "Series rescheduled"
+	case KindStarted:
+		return "Series started"
+	case KindGameEnded:
+		return e.Game + " over"
+	case KindSeriesWon:
+		return e.Winner + " wins"
+	case KindUpset:
+		return "Upset: " + e.Winner + " beats " + e.Loser
+	}
+	return string(e.Kind)
+}
+
+// Message describes the event in a sentence
+func (e Event) Message() string {
+	switch e.Kind {
+	case KindScheduled:
+		return fmt.Sprintf("%s is scheduled for %s", e.Series(), e.ScheduledAt.UTC().Format("2006-01-02 15:04 UTC"))
+	case KindRescheduled:
+		return fmt.Sprintf("%s moved from %s to %s", e.Series(),
+			e.PreviousScheduledAt.UTC().Format("2006-01-02 15:04"), e.ScheduledAt.UTC().Format("2006-01-02 15:04 UTC"))
+	case KindStarted:
+		return e.Series() + " is live"
+	case KindGameEnded:
+		winner := e.TeamA
+		if e.GameTeamBScore > e.GameTeamAScore {
+			winner = e.TeamB
+		}
+		return fmt.Sprintf("%s wins %s %d-%d, series %d-%d", teamName(winner), e.Game,
+			max(e.GameTeamAScore, e.GameTeamBScore), min(e.GameTeamAScore, e.GameTeamBScore), e.TeamAScore, e.TeamBScore)
+	case KindSeriesWon:
+		return fmt.Sprintf("%s beats %s %d-%d", e.Winner, e.Loser, max(e.TeamAScore, e.TeamBScore), min(e.TeamAScore, e.TeamBScore))
+	case KindUpset:
+		return fmt.Sprintf("%s beats %s %d-%d with a %.0f%% chance to win", e.Winner, e.Loser,
+			max(e.TeamAScore, e.TeamBScore), min(e.TeamAScore, e.TeamBScore), e.WinProbability*100)
+	}
+	return e.Series()
+}
+
+// Options configure the detection of events
+type Options struct {
+	// Ratings are the team ratings used to detect upsets, no upsets are detected without them
+	Ratings []ratings.Rating
+	// System is the rating system the ratings were computed with, Elo if it is empty
+	System ratings.System
+	// UpsetProbability is the win probability below which a series win counts as an upset
+	UpsetProbability float64
+}
+
+// Detect returns the events of the current state of the tournaments that were not handled yet.
+// Series that are new to the state are scheduled, series whose time differs from the time they
+// were announced at are rescheduled. Events are ordered by series time and lifecycle.
+// Known series are marked as seen at now.
+func Detect(tournaments []domain.TournamentMatches, state *State, opts Options, now time.Time) []Event {
+	upsets := upsetChances(opts)
+
+	var events []Event
+	for _, t := range tournaments {
+		for _, match := range t.Matches {
+			if match.UUID == "" {
+				continue
+			}
+			state.see(match.UUID, now)
+			for _, event := range matchEvents(t.Tournament, match, state, upsets) {
+				if !state.Handled(event.ID) {
+					events = append(events, event)
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].ScheduledAt.Before(events[j].ScheduledAt)
+	})
+	return events
+}
+
+// matchEvents returns the events of the current state of a series
+func matchEvents(tournament domain.Tournament, match domain.Match, state *State, upsets map[string]float64) []Event {
+	base := Event{
+		TournamentID:   tournament.ID,
+		TournamentName: tournament.Name,
+		MatchUUID:      match.UUID,
+		Stage:          match.Name,
+		Type:           match.Type,
+		ScheduledAt:    match.TimeOfSeries,
+		TeamA:          match.TeamA,
+		TeamB:          match.TeamB,
+		TeamAScore:     match.TeamAScore,
+		TeamBScore:     match.TeamBScore,
+	}
+	event := func(kind Kind, id string) Event {
+		e := base
+		e.Kind, e.ID = kind, match.UUID+"/"+id
+		return e
+	}
+
+	var events []Event
+	announced, known := state.Series[match.UUID]
+	upcoming := !match.IsLive && !match.IsCompleted && !match.TimeOfSeries.IsZero()
+	switch {
+	case upcoming && !known:
+		events = append(events, event(KindScheduled, "scheduled"))
+	case upcoming && !announced.Scheduled.Equal(match.TimeOfSeries):
+		e := event(KindRescheduled, "rescheduled/"+match.TimeOfSeries.UTC().Format(time.RFC3339))
+		e.PreviousScheduledAt = announced.Scheduled
+		events = append(events, e)
+	}
+
+	if match.IsInProgress() {
+		events = append(events, event(KindStarted, "started"))
+	}
+
+	for i, game := range match.Maps {
+		if game.MatchEndedTime.IsZero() || game.TeamAScore == game.TeamBScore {
+			continue
+		}
+		id := game.UUID
+		if id == "" {
+			id = fmt.Sprintf("%d", i+1)
+		}
+		e := event(KindGameEnded, "game/"+id)
+		e.Game, e.GameTeamAScore, e.GameTeamBScore = game.Name, game.TeamAScore, game.TeamBScore
+		if e.Game == "" {
+			e.Game = fmt.Sprintf("Game %d", i+1)
+		}
+		events = append(events, e)
+	}
+
+	// Between two games a series looks completed, it is only won once the series score decides it
+	if match.IsOver() && match.TeamAScore != match.TeamBScore {
+		winner, loser := match.TeamA, match.TeamB
+		if match.TeamBScore > match.TeamAScore {
+			winner, loser = loser, winner
+		}
+		won := event(KindSeriesWon, "won")
+		won.Winner, won.Loser = teamName(winner), teamName(loser)
+		events = append(events, won)
+
+		if chance, ok := upsets[match.UUID]; ok {
+			upset := event(KindUpset, "upset")
+			upset.Winner, upset.Loser, upset.WinProbability = won.Winner, won.Loser, chance
+			events = append(events, upset)
+		}
+	}
+	return events
+}
+
+// upsetChances returns the win probability of the winner of every series won against the odds,
+// based on the ratings of both teams before the series
+func upsetChances(opts Options) map[string]float64 {
+	chances := make(map[string]float64)
+	for _, rating := range opts.Ratings {
+		for _, change := range rating.History {
+			if !change.Won {
+				continue
+			}
+			before := ratings.Rating{Rating: change.Before, Deviation: change.Deviation}
+			opponent := ratings.Rating{Rating: change.OpponentRating, Deviation: change.OpponentDeviation}
+			chance := ratings.Expected(before, opponent, opts.System)
+			if chance < opts.UpsetProbability {
+				chances[change.MatchUUID] = chance
+			}
+		}
+	}
+	return chances
+}
+
+func teamName(team domain.MatchTeam) string {
+	if team.Name == "" {
+		return "TBD"
+	}
+	return team.Name
+}
@@ -0,0 +1,184 @@
+package notify
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/api/blast"
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/mapper"
+	"github.com/mgranderath/rlcs-cli/internal/ratings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	now     = time.Date(2026, 3, 5, 12, 0, 0, 0, time.UTC)
+	kickoff = time.Date(2026, 3, 5, 18, 0, 0, 0, time.UTC)
+)
+
+func final(mutate func(*domain.Match)) []domain.TournamentMatches {
+	match := domain.Match{
+		UUID:         "final",
+		Name:         "Grand Final",
+		Type:         "BO7",
+		TimeOfSeries: kickoff,
+		TeamA:        domain.MatchTeam{UUID: "vit", Name: "Team Vitality", Shorthand: "VIT"},
+		TeamB:        domain.MatchTeam{UUID: "kc", Name: "Karmine Corp", Shorthand: "KC"},
+	}
+	if mutate != nil {
+		mutate(&match)
+	}
+	return []domain.TournamentMatches{{
+		Tournament: domain.Tournament{ID: "major", Name: "RLCS 2026 Major 1"},
+		Matches:    []domain.Match{match},
+	}}
+}
+
+func kinds(events []Event) []Kind {
+	var out []Kind
+	for _, e := range events {
+		out = append(out, e.Kind)
+	}
+	return out
+}
+
+// handle detects the events of the tournaments and marks them as handled
+func handle(t *testing.T, state *State, tournaments []domain.TournamentMatches, opts Options) []Event {
+	t.Helper()
+	events := Detect(tournaments, state, opts, now)
+	for _, event := range events {
+		state.Handle(event, now)
+	}
+	return events
+}
+
+func TestDetect_Lifecycle(t *testing.T) {
+	state := NewState()
+	opts := Options{UpsetProbability: DefaultUpsetProbability}
+
+	events := handle(t, state, final(nil), opts)
+	require.Equal(t, []Kind{KindScheduled}, kinds(events))
+	assert.Equal(t, "final/scheduled", events[0].ID)
+	assert.Equal(t, "Team Vitality vs Karmine Corp is scheduled for 2026-03-05 18:00 UTC", events[0].Message())
+
+	// Nothing changed
+	assert.Empty(t, Detect(final(nil), state, opts, now))
+
+	later := kickoff.Add(2 * time.Hour)
+	events = handle(t, state, final(func(m *domain.Match) { m.TimeOfSeries = later }), opts)
+	require.Equal(t, []Kind{KindRescheduled}, kinds(events))
+	assert.Equal(t, kickoff, events[0].PreviousScheduledAt)
+	assert.Equal(t, "Team Vitality vs Karmine Corp moved from 2026-03-05 18:00 to 2026-03-05 20:00 UTC", events[0].Message())
+
+	live := func(m *domain.Match) {
+		m.TimeOfSeries = later
+		m.IsLive = true
+		m.TeamBScore = 1
+		m.Maps = []domain.MatchMap{{UUID: "g1", Name: "Game 1", ActualStartTime: later, MatchEndedTime: later.Add(7 * time.Minute), TeamAScore: 1, TeamBScore: 3}}
+	}
+	events = handle(t, state, final(live), opts)
+	require.Equal(t, []Kind{KindStarted, KindGameEnded}, kinds(events))
+	assert.Equal(t, "Karmine Corp wins Game 1 3-1, series 0-1", events[1].Message())
+
+	over := func(m *domain.Match) {
+		live(m)
+		m.IsLive, m.IsCompleted = false, true
+		m.TeamAScore, m.TeamBScore = 2, 4
+	}
+	events = handle(t, state, final(over), opts)
+	require.Equal(t, []Kind{KindSeriesWon}, kinds(events))
+	assert.Equal(t, "Karmine Corp", events[0].Winner)
+	assert.Equal(t, "Karmine Corp beats Team Vitality 4-2", events[0].Message())
+
+	assert.Empty(t, Detect(final(over), state, opts, now))
+}
+
+func TestDetect_Upset(t *testing.T) {
+	over := final(func(m *domain.Match) {
+		m.IsCompleted = true
+		m.TeamAScore, m.TeamBScore = 1, 4
+	})
+	opts := Options{
+		UpsetProbability: DefaultUpsetProbability,
+		Ratings: []ratings.Rating{{
+			TeamUUID: "kc",
+			History:  []ratings.Change{{MatchUUID: "final", Won: true, Before: 1450, OpponentRating: 1650}},
+		}},
+	}
+
+	events := Detect(over, NewState(), opts, now)
+	require.Equal(t, []Kind{KindSeriesWon, KindUpset}, kinds(events))
+	assert.InDelta(t, 0.24, events[1].WinProbability, 0.01)
+	assert.Equal(t, "Upset: Karmine Corp beats Team Vitality", events[1].Title())
+
+	// The uncertainty of Glicko-2 ratings flattens the odds
+	glicko := opts
+	glicko.System = ratings.SystemGlicko2
+	glicko.Ratings = []ratings.Rating{{
+		TeamUUID: "kc",
+		History:  []ratings.Change{{MatchUUID: "final", Won: true, Before: 1450, OpponentRating: 1650, Deviation: 300, OpponentDeviation: 300}},
+	}}
+	events = Detect(over, NewState(), glicko, now)
+	require.Equal(t, []Kind{KindSeriesWon, KindUpset}, kinds(events))
+	assert.InDelta(t, 0.33, events[1].WinProbability, 0.01)
+
+	// A favourite winning is no upset
+	opts.Ratings[0].History[0].Before = 1600
+	assert.Equal(t, []Kind{KindSeriesWon}, kinds(Detect(over, NewState(), opts, now)))
+}
+
+// mapped builds a best of 5 through the mapper, so that its status is inferred from the games like for
+// API responses. Each game is given by its winner, "A" or "B", games that are not listed have not started.
+func mapped(t *testing.T, games ...string) []domain.TournamentMatches {
+	t.Helper()
+	api := blast.MatchResponse{
+		ID:          "final",
+		Name:        "Grand Final",
+		Type:        "BO5",
+		ScheduledAt: kickoff.Format(time.RFC3339),
+		TeamA:       blast.MatchResponseTeam{ID: "vit", Name: "Team Vitality", ShortName: "VIT"},
+		TeamB:       blast.MatchResponseTeam{ID: "kc", Name: "Karmine Corp", ShortName: "KC"},
+	}
+	for i := 0; i < 5; i++ {
+		g := blast.MatchResponseMap{ID: fmt.Sprintf("g%d", i+1), Name: fmt.Sprintf("Game %d", i+1), ScheduledAt: kickoff.Format(time.RFC3339)}
+		if i < len(games) {
+			started := kickoff.Add(time.Duration(i) * 10 * time.Minute)
+			g.StartedAt, g.EndedAt = started.Format(time.RFC3339), started.Add(7*time.Minute).Format(time.RFC3339)
+			if games[i] == "A" {
+				g.TeamAScore = 1
+				api.TeamAScore++
+			} else {
+				g.TeamBScore = 1
+				api.TeamBScore++
+			}
+		}
+		api.Maps = append(api.Maps, g)
+	}
+
+	result := mapper.ToDomainMatchesFromResponse([]blast.MatchResponse{api})
+	require.Empty(t, result.Skipped)
+	return []domain.TournamentMatches{{
+		Tournament: domain.Tournament{ID: "major", Name: "RLCS 2026 Major 1"},
+		Matches:    result.Items,
+	}}
+}
+
+func TestDetect_BetweenGames(t *testing.T) {
+	state := NewState()
+	handle(t, state, mapped(t), Options{})
+
+	// The mapper reports a series between two games as completed
+	between := mapped(t, "A")
+	require.True(t, between[0].Matches[0].IsCompleted)
+	events := handle(t, state, between, Options{})
+	assert.Equal(t, []Kind{KindStarted, KindGameEnded}, kinds(events))
+
+	events = handle(t, state, mapped(t, "A", "B"), Options{})
+	assert.Equal(t, []Kind{KindGameEnded}, kinds(events))
+
+	events = handle(t, state, mapped(t, "A", "B", "A", "A"), Options{})
+	require.Equal(t, []Kind{KindGameEnded, KindGameEnded, KindSeriesWon}, kinds(events))
+	assert.Equal(t, "Team Vitality beats Karmine Corp 3-1", events[2].Message())
+}
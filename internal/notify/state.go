@@ -0,0 +1,159 @@
+package notify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// retention is how long handled events are remembered, series are not expected to change after that
+const retention = 60 * 24 * time.Hour
+
+// State remembers the events that were handled and the webhooks they were delivered to,
+// so that restarts do not send events again
+type State struct {
+	// Series holds the time each known series was announced at, keyed by match UUID
+	Series map[string]Series `json:"series"`
+	// Events holds the time every handled event was handled at, keyed by event ID
+	Events map[string]time.Time `json:"events"`
+	// Delivered holds the time events were delivered to a webhook, keyed by event ID and webhook name
+	Delivered map[string]time.Time `json:"delivered"`
+}
+
+// Series is the last announced state of a series
+type Series struct {
+	Scheduled time.Time `json:"scheduled"`
+	// Seen is the last time the series was part of the fetched data
+	Seen time.Time `json:"seen"`
+}
+
+// NewState returns an empty state
+func NewState() *State {
+	return &State{
+		Series:    make(map[string]Series),
+		Events:    make(map[string]time.Time),
+		Delivered: make(map[string]time.Time),
+	}
+}
+
+// LoadState reads the state file at path.
+// A missing file is not an error and results in an empty state.
+func LoadState(path string) (*State, error) {
+	state := NewState()
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read notify state: %w", err)
+	}
+
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to parse notify state %s: %w", path, err)
+	}
+	if state.Series == nil {
+		state.Series = make(map[string]Series)
+	}
+	if state.Events == nil {
+		state.Events = make(map[string]time.Time)
+	}
+	if state.Delivered == nil {
+		state.Delivered = make(map[string]time.Time)
+	}
+	return state, nil
+}
+
+// Save writes the state file to path, creating its directory if needed
+func (s *State) Save(path string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode notify state: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create notify state dir: %w", err)
+	}
+	// Write to a temporary file first so that an interrupted write does not lose the state
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write notify state: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write notify state: %w", err)
+	}
+	return nil
+}
+
+// Empty returns true if no event was handled yet
+func (s *State) Empty() bool {
+	return len(s.Series) == 0 && len(s.Events) == 0
+}
+
+// Handled returns true if the event with the given ID was handled
+func (s *State) Handled(id string) bool {
+	_, ok := s.Events[id]
+	return ok
+}
+
+// Handle marks an event as handled, it is not detected again
+func (s *State) Handle(event Event, now time.Time) {
+	s.Events[event.ID] = now
+	series := s.Series[event.MatchUUID]
+	if series.Scheduled.IsZero() || event.Kind == KindScheduled || event.Kind == KindRescheduled {
+		series.Scheduled = event.ScheduledAt
+	}
+	series.Seen = now
+	s.Series[event.MatchUUID] = series
+}
+
+// WasDelivered returns true if the event was delivered to the webhook
+func (s *State) WasDelivered(webhook string, event Event) bool {
+	_, ok := s.Delivered[deliveryKey(webhook, event)]
+	return ok
+}
+
+// Deliver marks an event as delivered to the webhook
+func (s *State) Deliver(webhook string, event Event, now time.Time) {
+	s.Delivered[deliveryKey(webhook, event)] = now
+}
+
+// Prune forgets the series that were not part of the fetched data for longer than the retention
+// period along with their events. Events of series that are still fetched are kept, so they are not sent again.
+func (s *State) Prune(now time.Time) {
+	cutoff := now.Add(-retention)
+	for uuid, series := range s.Series {
+		if series.Seen.Before(cutoff) {
+			delete(s.Series, uuid)
+		}
+	}
+	for _, events := range []map[string]time.Time{s.Events, s.Delivered} {
+		for key := range events {
+			if _, ok := s.Series[matchOf(key)]; !ok {
+				delete(events, key)
+			}
+		}
+	}
+}
+
+// see refreshes the time a known series was last part of the fetched data
+func (s *State) see(uuid string, now time.Time) {
+	if series, ok := s.Series[uuid]; ok {
+		series.Seen = now
+		s.Series[uuid] = series
+	}
+}
+
+func deliveryKey(webhook string, event Event) string {
+	return event.ID + " " + webhook
+}
+
+// matchOf returns the match UUID of an event ID or delivery key
+func matchOf(key string) string {
+	uuid, _, _ := strings.Cut(key, "/")
+	return uuid
+}
@@ -0,0 +1,49 @@
+package notify
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestState_SaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "notify.json")
+
+	state, err := LoadState(path)
+	require.NoError(t, err)
+	assert.True(t, state.Empty())
+
+	events := Detect(final(nil), state, Options{}, now)
+	require.Len(t, events, 1)
+	state.Handle(events[0], now)
+	state.Deliver("team", events[0], now)
+	require.NoError(t, state.Save(path))
+
+	state, err = LoadState(path)
+	require.NoError(t, err)
+	assert.False(t, state.Empty())
+	assert.True(t, state.Handled("final/scheduled"))
+	assert.True(t, state.WasDelivered("team", events[0]))
+	assert.False(t, state.WasDelivered("other", events[0]))
+	assert.Equal(t, kickoff, state.Series["final"].Scheduled)
+}
+
+func TestState_Prune(t *testing.T) {
+	state := NewState()
+	events := Detect(final(nil), state, Options{}, now)
+	state.Handle(events[0], now)
+	state.Deliver("team", events[0], now)
+
+	// Series that are still fetched are kept
+	later := now.Add(retention + 24*time.Hour)
+	Detect(final(nil), state, Options{}, later)
+	state.Prune(later)
+	assert.True(t, state.Handled("final/scheduled"))
+
+	state.Prune(later.Add(retention + 1))
+	assert.True(t, state.Empty())
+	assert.Empty(t, state.Delivered)
+}
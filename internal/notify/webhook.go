@@ -0,0 +1,305 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/teams"
+)
+
+// Format is the payload format of a webhook
+type Format string
+
+const (
+	// FormatJSON posts the event as generic JSON
+	FormatJSON Format = "json"
+	// FormatDiscord posts the event as a Discord embed
+	FormatDiscord Format = "discord"
+	// FormatSlack posts the event as Slack blocks
+	FormatSlack Format = "slack"
+)
+
+// defaultTimeout is the timeout of a single webhook request
+const defaultTimeout = 10 * time.Second
+
+// Webhook is an endpoint events are posted to
+type Webhook struct {
+	// Name identifies the webhook in the state and in messages, defaults to its URL
+	Name   string
+	URL    string
+	Format Format
+	// Teams restricts the webhook to the series of the teams matching any of the queries (UUID, name or shorthand)
+	Teams []string
+	// Kinds restricts the webhook to events of these kinds, all kinds are sent if it is empty
+	Kinds []Kind
+}
+
+// Validate checks the webhook
+func (w Webhook) Validate() error {
+	if w.URL == "" {
+		return fmt.Errorf("webhook %s has no url", w.Name)
+	}
+	if !strings.HasPrefix(w.URL, "http://") && !strings.HasPrefix(w.URL, "https://") {
+		return fmt.Errorf("webhook %s: url must start with http:// or https://", w.Name)
+	}
+	switch w.Format {
+	case FormatJSON, FormatDiscord, FormatSlack:
+	default:
+		return fmt.Errorf("webhook %s: unknown format %q, must be one of: json, discord, slack", w.Name, w.Format)
+	}
+	for _, kind := range w.Kinds {
+		if !validKind(kind) {
+			return fmt.Errorf("webhook %s: unknown event %q", w.Name, kind)
+		}
+	}
+	return nil
+}
+
+// Accepts returns true if the event is sent to the webhook
+func (w Webhook) Accepts(event Event) bool {
+	if len(w.Kinds) > 0 {
+		accepted := false
+		for _, kind := range w.Kinds {
+			accepted = accepted || kind == event.Kind
+		}
+		if !accepted {
+			return false
+		}
+	}
+	if len(w.Teams) == 0 {
+		return true
+	}
+	for _, query := range w.Teams {
+		if teams.IsParticipant(event.TeamA, query) || teams.IsParticipant(event.TeamB, query) {
+			return true
+		}
+	}
+	return false
+}
+
+// Sender posts events to webhooks
+type Sender struct {
+	Client *http.Client
+}
+
+// NewSender returns a sender with a default HTTP client
+func NewSender() *Sender {
+	return &Sender{Client: &http.Client{Timeout: defaultTimeout}}
+}
+
+// Send posts the event to the webhook. Responses other than 2xx are returned as errors.
+func (s *Sender) Send(ctx context.Context, webhook Webhook, event Event) error {
+	payload, err := Payload(webhook.Format, event)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "rlcs-cli")
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post to webhook %s: %w", webhook.Name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("webhook %s returned %s: %s", webhook.Name, resp.Status, strings.TrimSpace(string(detail)))
+	}
+	return nil
+}
+
+// Payload returns the request body of an event in the given format
+func Payload(format Format, event Event) (any, error) {
+	switch format {
+	case FormatJSON:
+		return jsonPayload(event), nil
+	case FormatDiscord:
+		return discordPayload(event), nil
+	case FormatSlack:
+		return slackPayload(event), nil
+	}
+	return nil, fmt.Errorf("unknown webhook format %q, must be one of: json, discord, slack", format)
+}
+
+// eventJSON is the generic JSON payload
+type eventJSON struct {
+	ID                  string     `json:"id"`
+	Kind                Kind       `json:"kind"`
+	Title               string     `json:"title"`
+	Message             string     `json:"message"`
+	TournamentID        string     `json:"tournament_id"`
+	TournamentName      string     `json:"tournament_name"`
+	MatchUUID           string     `json:"match_uuid"`
+	Stage               string     `json:"stage"`
+	Type                string     `json:"type"`
+	ScheduledAt         *time.Time `json:"scheduled_at,omitempty"`
+	PreviousScheduledAt *time.Time `json:"previous_scheduled_at,omitempty"`
+	TeamA               teamJSON   `json:"team_a"`
+	TeamB               teamJSON   `json:"team_b"`
+	Game                *gameJSON  `json:"game,omitempty"`
+	Winner              string     `json:"winner,omitempty"`
+	Loser               string     `json:"loser,omitempty"`
+	WinProbability      float64    `json:"win_probability,omitempty"`
+}
+
+type teamJSON struct {
+	UUID      string `json:"uuid"`
+	Name      string `json:"name"`
+	Shorthand string `json:"shorthand"`
+	Score     int    `json:"score"`
+}
+
+type gameJSON struct {
+	Name       string `json:"name"`
+	TeamAGoals int    `json:"team_a_goals"`
+	TeamBGoals int    `json:"team_b_goals"`
+}
+
+func jsonPayload(event Event) eventJSON {
+	payload := eventJSON{
+		ID:                  event.ID,
+		Kind:                event.Kind,
+		Title:               event.Title(),
+		Message:             event.Message(),
+		TournamentID:        event.TournamentID,
+		TournamentName:      event.TournamentName,
+		MatchUUID:           event.MatchUUID,
+		Stage:               event.Stage,
+		Type:                event.Type,
+		ScheduledAt:         optionalTime(event.ScheduledAt),
+		PreviousScheduledAt: optionalTime(event.PreviousScheduledAt),
+		TeamA:               teamJSON{UUID: event.TeamA.UUID, Name: event.TeamA.Name, Shorthand: event.TeamA.Shorthand, Score: event.TeamAScore},
+		TeamB:               teamJSON{UUID: event.TeamB.UUID, Name: event.TeamB.Name, Shorthand: event.TeamB.Shorthand, Score: event.TeamBScore},
+		Winner:              event.Winner,
+		Loser:               event.Loser,
+		WinProbability:      event.WinProbability,
+	}
+	if event.Kind == KindGameEnded {
+		payload.Game = &gameJSON{Name: event.Game, TeamAGoals: event.GameTeamAScore, TeamBGoals: event.GameTeamBScore}
+	}
+	return payload
+}
+
+// Discord embed colors per event kind
+var discordColors = map[Kind]int{
+	KindScheduled:   0x5865F2,
+	KindRescheduled: 0xFEE75C,
+	KindStarted:     0xED4245,
+	KindGameEnded:   0x99AAB5,
+	KindSeriesWon:   0x57F287,
+	KindUpset:       0xEB459E,
+}
+
+type discordMessage struct {
+	Username string         `json:"username"`
+	Embeds   []discordEmbed `json:"embeds"`
+}
+
+type discordEmbed struct {
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Color       int            `json:"color"`
+	Timestamp   string         `json:"timestamp,omitempty"`
+	Fields      []discordField `json:"fields,omitempty"`
+	Footer      *discordFooter `json:"footer,omitempty"`
+}
+
+type discordField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline"`
+}
+
+type discordFooter struct {
+	Text string `json:"text"`
+}
+
+func discordPayload(event Event) discordMessage {
+	embed := discordEmbed{
+		Title:       event.Title(),
+		Description: event.Message(),
+		Color:       discordColors[event.Kind],
+		Fields: []discordField{
+			{Name: "Series", Value: event.Series(), Inline: true},
+			{Name: "Score", Value: fmt.Sprintf("%d-%d", event.TeamAScore, event.TeamBScore), Inline: true},
+		},
+	}
+	if !event.ScheduledAt.IsZero() {
+		embed.Timestamp = event.ScheduledAt.UTC().Format(time.RFC3339)
+	}
+	if footer := joinNonEmpty(" · ", event.TournamentName, event.Stage, event.Type); footer != "" {
+		embed.Footer = &discordFooter{Text: footer}
+	}
+	return discordMessage{Username: "rlcs-cli", Embeds: []discordEmbed{embed}}
+}
+
+type slackMessage struct {
+	Text   string       `json:"text"`
+	Blocks []slackBlock `json:"blocks"`
+}
+
+type slackBlock struct {
+	Type     string      `json:"type"`
+	Text     *slackText  `json:"text,omitempty"`
+	Elements []slackText `json:"elements,omitempty"`
+}
+
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+func slackPayload(event Event) slackMessage {
+	blocks := []slackBlock{
+		{Type: "header", Text: &slackText{Type: "plain_text", Text: event.Title()}},
+		{Type: "section", Text: &slackText{Type: "mrkdwn", Text: event.Message()}},
+	}
+	if details := joinNonEmpty(" · ", event.TournamentName, event.Stage, event.Type); details != "" {
+		blocks = append(blocks, slackBlock{Type: "context", Elements: []slackText{{Type: "mrkdwn", Text: details}}})
+	}
+	// Text is the fallback shown in notifications
+	return slackMessage{Text: event.Title() + ": " + event.Message(), Blocks: blocks}
+}
+
+func validKind(kind Kind) bool {
+	for _, k := range Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
+
+func joinNonEmpty(sep string, values ...string) string {
+	var parts []string
+	for _, value := range values {
+		if value != "" {
+			parts = append(parts, value)
+		}
+	}
+	return strings.Join(parts, sep)
+}
@@ -0,0 +1,82 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func wonEvent() Event {
+	events := Detect(final(func(m *domain.Match) {
+		m.IsCompleted = true
+		m.TeamAScore, m.TeamBScore = 4, 2
+	}), NewState(), Options{}, now)
+	return events[0]
+}
+
+func TestSender_Send(t *testing.T) {
+	var bodies []map[string]any
+	status := http.StatusNoContent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var body map[string]any
+		require.NoError(t, json.Unmarshal(data, &body))
+		bodies = append(bodies, body)
+		w.WriteHeader(status)
+		io.WriteString(w, "nope")
+	}))
+	defer server.Close()
+
+	sender := NewSender()
+	event := wonEvent()
+	for _, format := range []Format{FormatJSON, FormatDiscord, FormatSlack} {
+		require.NoError(t, sender.Send(context.Background(), Webhook{Name: string(format), URL: server.URL, Format: format}, event))
+	}
+	require.Len(t, bodies, 3)
+
+	assert.Equal(t, "final/won", bodies[0]["id"])
+	assert.Equal(t, "series_won", bodies[0]["kind"])
+	assert.Equal(t, "Team Vitality beats Karmine Corp 4-2", bodies[0]["message"])
+	assert.Equal(t, "2026-03-05T18:00:00Z", bodies[0]["scheduled_at"])
+	assert.Equal(t, float64(4), bodies[0]["team_a"].(map[string]any)["score"])
+
+	embed := bodies[1]["embeds"].([]any)[0].(map[string]any)
+	assert.Equal(t, "Team Vitality wins", embed["title"])
+	assert.Equal(t, "RLCS 2026 Major 1 · Grand Final · BO7", embed["footer"].(map[string]any)["text"])
+
+	blocks := bodies[2]["blocks"].([]any)
+	assert.Len(t, blocks, 3)
+	assert.Equal(t, "Team Vitality wins: Team Vitality beats Karmine Corp 4-2", bodies[2]["text"])
+
+	status = http.StatusBadRequest
+	err := sender.Send(context.Background(), Webhook{Name: "team", URL: server.URL, Format: FormatJSON}, event)
+	assert.EqualError(t, err, "webhook team returned 400 Bad Request: nope")
+}
+
+func TestWebhook_Accepts(t *testing.T) {
+	event := wonEvent()
+
+	assert.True(t, Webhook{}.Accepts(event))
+	assert.True(t, Webhook{Teams: []string{"G2", "kc"}}.Accepts(event))
+	assert.False(t, Webhook{Teams: []string{"G2"}}.Accepts(event))
+	assert.True(t, Webhook{Kinds: []Kind{KindUpset, KindSeriesWon}}.Accepts(event))
+	assert.False(t, Webhook{Kinds: []Kind{KindStarted}}.Accepts(event))
+}
+
+func TestWebhook_Validate(t *testing.T) {
+	assert.NoError(t, Webhook{Name: "a", URL: "http://localhost:8080/hook", Format: FormatSlack}.Validate())
+	assert.EqualError(t, Webhook{Name: "a", Format: FormatJSON}.Validate(), "webhook a has no url")
+	assert.EqualError(t, Webhook{Name: "a", URL: "localhost", Format: FormatJSON}.Validate(), "webhook a: url must start with http:// or https://")
+	assert.EqualError(t, Webhook{Name: "a", URL: "http://x", Format: "xml"}.Validate(), `webhook a: unknown format "xml", must be one of: json, discord, slack`)
+	assert.EqualError(t, Webhook{Name: "a", URL: "http://x", Format: FormatJSON, Kinds: []Kind{"goal"}}.Validate(), `webhook a: unknown event "goal"`)
+}
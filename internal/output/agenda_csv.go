@@ -0,0 +1,50 @@
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+)
+
+// AgendaCSVFormatter outputs series as CSV
+type AgendaCSVFormatter struct{}
+
+func (f *AgendaCSVFormatter) Format(w io.Writer, games []domain.GameListing) error {
+	writer := csv.NewWriter(w)
+	defer writer.Flush()
+
+	// Write header
+	header := []string{"TournamentID", "Tournament", "MatchUUID", "Match", "Type", "Time", "TeamA", "TeamB", "TeamAScore", "TeamBScore", "Status"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	// Write rows
+	for _, game := range games {
+		match := game.Match
+		kickoff := ""
+		if !match.TimeOfSeries.IsZero() {
+			kickoff = match.TimeOfSeries.Format("2006-01-02T15:04:05Z07:00")
+		}
+		record := []string{
+			game.TournamentID,
+			game.TournamentName,
+			match.UUID,
+			match.Name,
+			match.Type,
+			kickoff,
+			match.TeamA.Name,
+			match.TeamB.Name,
+			fmt.Sprintf("%d", match.TeamAScore),
+			fmt.Sprintf("%d", match.TeamBScore),
+			formatMatchStatus(match),
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write CSV record: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+)
+
+// AgendaFormatter defines the interface for formatters of the series of followed teams
+type AgendaFormatter interface {
+	Format(w io.Writer, games []domain.GameListing) error
+}
+
+// agendaRegistry holds constructors of all registered agenda formatters, now returns the
+// current time relative times are computed from
+var agendaRegistry = map[Format]func(now func() time.Time) AgendaFormatter{
+	FormatTable: func(now func() time.Time) AgendaFormatter { return &AgendaTableFormatter{Now: now} },
+	FormatJSON:  func(func() time.Time) AgendaFormatter { return &AgendaJSONFormatter{} },
+	FormatCSV:   func(func() time.Time) AgendaFormatter { return &AgendaCSVFormatter{} },
+	FormatYAML:  func(func() time.Time) AgendaFormatter { return &AgendaYAMLFormatter{} },
+}
+
+// GetAgendaFormatter returns the formatter for the given format, now returns the current time relative times are computed from
+func GetAgendaFormatter(format Format, now func() time.Time) (AgendaFormatter, error) {
+	newFormatter, ok := agendaRegistry[format]
+	if !ok {
+		return nil, fmt.Errorf("no formatter registered for format: %s", format)
+	}
+	return newFormatter(now), nil
+}
@@ -0,0 +1,17 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+)
+
+// AgendaJSONFormatter outputs series as formatted JSON
+type AgendaJSONFormatter struct{}
+
+func (f *AgendaJSONFormatter) Format(w io.Writer, games []domain.GameListing) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(games)
+}
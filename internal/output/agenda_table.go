@@ -0,0 +1,49 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+)
+
+// AgendaTableFormatter outputs series with their kickoff times as an ASCII table
+type AgendaTableFormatter struct {
+	// Now returns the current time relative times are computed from, defaults to time.Now
+	Now func() time.Time
+}
+
+func (f *AgendaTableFormatter) Format(w io.Writer, games []domain.GameListing) error {
+	if len(games) == 0 {
+		fmt.Fprintln(w, "No series found")
+		return nil
+	}
+
+	// Write header
+	fmt.Fprintln(w, "┌──────────────────┬─────────────────────┬───────────────────────┬────────────────────┬─────────────────────────────────────┬─────────┬─────────────┐")
+	fmt.Fprintln(w, "│ Kickoff          │ When                │ Tournament            │ Match              │ Teams                               │ Score   │ Status      │")
+	fmt.Fprintln(w, "├──────────────────┼─────────────────────┼───────────────────────┼────────────────────┼─────────────────────────────────────┼─────────┼─────────────┤")
+
+	// Write series
+	current := currentTime(f.Now)
+	for _, game := range games {
+		kickoff := formatSeriesTime(game.Match.TimeOfSeries)
+		when := formatWhen(game.Match, current)
+		tournament := truncate(game.TournamentName, 21)
+		name := truncate(game.Match.Name, 18)
+		teams := fmt.Sprintf("%s vs %s", truncate(game.Match.TeamA.Name, 15), truncate(game.Match.TeamB.Name, 15))
+		score := "-"
+		if game.Match.IsLive || game.Match.IsCompleted {
+			score = fmt.Sprintf("%d - %d", game.Match.TeamAScore, game.Match.TeamBScore)
+		}
+		status := formatMatchStatus(game.Match)
+
+		fmt.Fprintf(w, "│ %-16s │ %-19s │ %-21s │ %-18s │ %-35s │ %-7s │ %-11s │\n",
+			kickoff, when, tournament, name, teams, score, status)
+	}
+
+	fmt.Fprintln(w, "└──────────────────┴─────────────────────┴───────────────────────┴────────────────────┴─────────────────────────────────────┴─────────┴─────────────┘")
+
+	return nil
+}
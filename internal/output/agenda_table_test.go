@@ -0,0 +1,58 @@
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testAgenda() []domain.GameListing {
+	return []domain.GameListing{
+		{TournamentID: "major", TournamentName: "RLCS 2026 Major 1", Match: domain.Match{
+			UUID: "p-sf2", Name: "Semifinal 2", Type: "BO7", TimeOfSeries: time.Date(2026, 3, 4, 17, 0, 0, 0, time.UTC),
+			TeamA: domain.MatchTeam{Name: "Team Falcons"}, TeamB: domain.MatchTeam{Name: "Spacestation Gaming"},
+			TeamAScore: 1, TeamBScore: 1, IsLive: true,
+		}},
+		{TournamentID: "major", TournamentName: "RLCS 2026 Major 1", Match: domain.Match{
+			UUID: "p-final", Name: "Grand Final", Type: "BO7", TimeOfSeries: time.Date(2026, 3, 5, 18, 0, 0, 0, time.UTC),
+			TeamA: domain.MatchTeam{Name: "Team Vitality"}, TeamB: domain.MatchTeam{Name: "TBD"},
+		}},
+	}
+}
+
+func TestAgendaTableFormatter_Format(t *testing.T) {
+	formatter := &AgendaTableFormatter{}
+
+	t.Run("no series", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, formatter.Format(&buf, nil))
+		assert.Equal(t, "No series found\n", buf.String())
+	})
+
+	t.Run("series", func(t *testing.T) {
+		formatter, err := GetAgendaFormatter(FormatTable, func() time.Time { return time.Date(2026, 3, 4, 17, 35, 0, 0, time.UTC) })
+		require.NoError(t, err)
+		var buf bytes.Buffer
+		require.NoError(t, formatter.Format(&buf, testAgenda()))
+
+		out := buf.String()
+		for _, s := range []string{"Kickoff", "2026-03-04 17:00", "started 35m ago", "1 - 1", "LIVE", "2026-03-05 18:00", "in 1d", "Team Vitality vs TBD", "Upcoming"} {
+			assert.Contains(t, out, s)
+		}
+	})
+}
+
+func TestAgendaCSVFormatter_Format(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, (&AgendaCSVFormatter{}).Format(&buf, testAgenda()))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, []string{"major", "RLCS 2026 Major 1", "p-final", "Grand Final", "BO7", "2026-03-05T18:00:00Z", "Team Vitality", "TBD", "0", "0", "Upcoming"}, records[2])
+}
@@ -0,0 +1,27 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"gopkg.in/yaml.v3"
+)
+
+// AgendaYAMLFormatter outputs series as YAML
+type AgendaYAMLFormatter struct{}
+
+func (f *AgendaYAMLFormatter) Format(w io.Writer, games []domain.GameListing) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(games); err != nil {
+		return fmt.Errorf("failed to encode series to YAML: %w", err)
+	}
+
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to close YAML encoder: %w", err)
+	}
+
+	return nil
+}
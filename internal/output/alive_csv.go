@@ -0,0 +1,52 @@
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/graph"
+)
+
+// AliveCSVFormatter outputs remaining teams as CSV
+type AliveCSVFormatter struct{}
+
+func (f *AliveCSVFormatter) Format(w io.Writer, remaining []graph.Remaining) error {
+	writer := csv.NewWriter(w)
+	defer writer.Flush()
+
+	// Write header
+	header := []string{"TeamUUID", "Team", "Shorthand", "Status", "Bracket", "SeriesWins", "SeriesLosses", "Risk", "NextSeriesUUID", "NextSeries", "Opponent", "Time", "Live"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	// Write rows
+	for _, r := range remaining {
+		record := []string{
+			r.TeamUUID,
+			r.Team,
+			r.Shorthand,
+			string(r.Status),
+			r.Bracket,
+			fmt.Sprintf("%d", r.SeriesWins),
+			fmt.Sprintf("%d", r.SeriesLosses),
+			string(r.Risk),
+			"", "", "", "", "",
+		}
+		if next := r.Next; next != nil {
+			record[8] = next.SeriesUUID
+			record[9] = next.Series
+			record[10] = next.Opponent
+			if !next.Time.IsZero() {
+				record[11] = next.Time.Format("2006-01-02T15:04:05Z07:00")
+			}
+			record[12] = fmt.Sprintf("%t", next.Live)
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write CSV record: %w", err)
+		}
+	}
+
+	return nil
+}
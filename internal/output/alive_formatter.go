@@ -0,0 +1,30 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/graph"
+)
+
+// AliveFormatter defines the interface for remaining team output formatters
+type AliveFormatter interface {
+	Format(w io.Writer, remaining []graph.Remaining) error
+}
+
+// aliveRegistry holds all registered remaining team formatters
+var aliveRegistry = map[Format]AliveFormatter{
+	FormatTable: &AliveTableFormatter{},
+	FormatJSON:  &AliveJSONFormatter{},
+	FormatCSV:   &AliveCSVFormatter{},
+	FormatYAML:  &AliveYAMLFormatter{},
+}
+
+// GetAliveFormatter returns the formatter for the given format
+func GetAliveFormatter(format Format) (AliveFormatter, error) {
+	formatter, ok := aliveRegistry[format]
+	if !ok {
+		return nil, fmt.Errorf("no formatter registered for format: %s", format)
+	}
+	return formatter, nil
+}
@@ -0,0 +1,17 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/graph"
+)
+
+// AliveJSONFormatter outputs remaining teams as formatted JSON
+type AliveJSONFormatter struct{}
+
+func (f *AliveJSONFormatter) Format(w io.Writer, remaining []graph.Remaining) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(remaining)
+}
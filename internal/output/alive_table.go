@@ -0,0 +1,62 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/graph"
+)
+
+// AliveTableFormatter outputs remaining teams as an ASCII table
+type AliveTableFormatter struct{}
+
+func (f *AliveTableFormatter) Format(w io.Writer, remaining []graph.Remaining) error {
+	if len(remaining) == 0 {
+		fmt.Fprintln(w, "No remaining teams found")
+		return nil
+	}
+
+	// Write header
+	fmt.Fprintln(w, "┌──────────────────────────┬───────┬────────────────┬────────┬────────────────────────┬──────────────────────────┬──────────────────┬─────────────┐")
+	fmt.Fprintln(w, "│ Team                     │ Tag   │ Bracket        │ Record │ Next                   │ Opponent                 │ Kickoff          │ Risk        │")
+	fmt.Fprintln(w, "├──────────────────────────┼───────┼────────────────┼────────┼────────────────────────┼──────────────────────────┼──────────────────┼─────────────┤")
+
+	// Write teams
+	for _, r := range remaining {
+		team := truncate(r.Team, 24)
+		tag := truncate(r.Shorthand, 5)
+		bracket := truncate(r.Bracket, 14)
+		record := fmt.Sprintf("%d-%d", r.SeriesWins, r.SeriesLosses)
+		next, opponent, kickoff := "-", "-", "-"
+		if r.Status == graph.StatusWinner {
+			next = "Won the tournament"
+		}
+		if r.Next != nil {
+			next = truncate(r.Next.Series, 22)
+			opponent = truncate(r.Next.Opponent, 24)
+			kickoff = formatKickoff(*r.Next)
+		}
+		risk := string(r.Risk)
+		if risk == "" {
+			risk = "-"
+		}
+
+		fmt.Fprintf(w, "│ %-24s │ %-5s │ %-14s │ %-6s │ %-22s │ %-24s │ %-16s │ %-11s │\n",
+			team, tag, bracket, record, next, opponent, kickoff, risk)
+	}
+
+	fmt.Fprintln(w, "└──────────────────────────┴───────┴────────────────┴────────┴────────────────────────┴──────────────────────────┴──────────────────┴─────────────┘")
+
+	return nil
+}
+
+// formatKickoff returns the score of a live series, otherwise its scheduled start
+func formatKickoff(step graph.Step) string {
+	switch {
+	case step.Live:
+		return fmt.Sprintf("LIVE %d-%d", step.TeamScore, step.OpponentScore)
+	case step.Time.IsZero():
+		return "TBD"
+	}
+	return step.Time.Format("2006-01-02 15:04")
+}
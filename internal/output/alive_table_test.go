@@ -0,0 +1,57 @@
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/graph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRemaining() []graph.Remaining {
+	return []graph.Remaining{
+		{
+			TeamUUID: "flcn", Team: "Team Falcons", Shorthand: "FLCN", Status: graph.StatusAlive, Bracket: "Playoffs", Risk: graph.RiskElimination,
+			Next: &graph.Step{SeriesUUID: "p-sf2", Series: "Semifinal 2", Opponent: "Spacestation Gaming", Live: true, TeamScore: 1, OpponentScore: 1},
+		},
+		{
+			TeamUUID: "vit", Team: "Team Vitality", Shorthand: "VIT", Status: graph.StatusAlive, Bracket: "Playoffs", SeriesWins: 1, Risk: graph.RiskElimination,
+			Next: &graph.Step{SeriesUUID: "p-final", Series: "Grand Final", Opponent: "Winner of Semifinal 2", Time: time.Date(2026, 3, 5, 18, 0, 0, 0, time.UTC)},
+		},
+		{TeamUUID: "g2", Team: "G2 Esports", Shorthand: "G2", Status: graph.StatusAlive, Bracket: "Swiss", SeriesWins: 2, SeriesLosses: 1},
+	}
+}
+
+func TestAliveTableFormatter_Format(t *testing.T) {
+	formatter := &AliveTableFormatter{}
+
+	t.Run("no teams", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, formatter.Format(&buf, nil))
+		assert.Equal(t, "No remaining teams found\n", buf.String())
+	})
+
+	t.Run("teams", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, formatter.Format(&buf, testRemaining()))
+
+		out := buf.String()
+		for _, s := range []string{"Team Falcons", "LIVE 1-1", "Winner of Semifinal 2", "2026-03-05 18:00", "Elimination", "2-1"} {
+			assert.Contains(t, out, s)
+		}
+	})
+}
+
+func TestAliveCSVFormatter_Format(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, (&AliveCSVFormatter{}).Format(&buf, testRemaining()))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+	assert.Equal(t, []string{"vit", "Team Vitality", "VIT", "Alive", "Playoffs", "1", "0", "Elimination", "p-final", "Grand Final", "Winner of Semifinal 2", "2026-03-05T18:00:00Z", "false"}, records[2])
+	assert.Equal(t, []string{"g2", "G2 Esports", "G2", "Alive", "Swiss", "2", "1", "", "", "", "", "", ""}, records[3])
+}
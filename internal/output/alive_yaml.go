@@ -0,0 +1,27 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/graph"
+	"gopkg.in/yaml.v3"
+)
+
+// AliveYAMLFormatter outputs remaining teams as YAML
+type AliveYAMLFormatter struct{}
+
+func (f *AliveYAMLFormatter) Format(w io.Writer, remaining []graph.Remaining) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(remaining); err != nil {
+		return fmt.Errorf("failed to encode remaining teams to YAML: %w", err)
+	}
+
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to close YAML encoder: %w", err)
+	}
+
+	return nil
+}
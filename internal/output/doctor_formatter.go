@@ -0,0 +1,38 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/schema"
+)
+
+// DoctorFormatter defines the interface for schema issue output formatters
+type DoctorFormatter interface {
+	Format(w io.Writer, issues []schema.Issue) error
+}
+
+// DoctorFormat represents the output format for schema issues
+type DoctorFormat string
+
+const (
+	DoctorFormatTable DoctorFormat = "table"
+	DoctorFormatJSON  DoctorFormat = "json"
+	DoctorFormatYAML  DoctorFormat = "yaml"
+)
+
+// doctorRegistry holds all registered schema issue formatters
+var doctorRegistry = map[DoctorFormat]DoctorFormatter{
+	DoctorFormatTable: &DoctorTableFormatter{},
+	DoctorFormatJSON:  &DoctorJSONFormatter{},
+	DoctorFormatYAML:  &DoctorYAMLFormatter{},
+}
+
+// GetDoctorFormatter returns the formatter for the given format
+func GetDoctorFormatter(format DoctorFormat) (DoctorFormatter, error) {
+	formatter, ok := doctorRegistry[format]
+	if !ok {
+		return nil, fmt.Errorf("no formatter registered for format: %s", format)
+	}
+	return formatter, nil
+}
@@ -0,0 +1,17 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/schema"
+)
+
+// DoctorJSONFormatter outputs schema issues as formatted JSON
+type DoctorJSONFormatter struct{}
+
+func (f *DoctorJSONFormatter) Format(w io.Writer, issues []schema.Issue) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(issues)
+}
@@ -0,0 +1,39 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/schema"
+)
+
+// DoctorTableFormatter outputs schema issues as an ASCII table
+type DoctorTableFormatter struct{}
+
+func (f *DoctorTableFormatter) Format(w io.Writer, issues []schema.Issue) error {
+	if len(issues) == 0 {
+		fmt.Fprintln(w, "No schema issues found")
+		return nil
+	}
+
+	// Write header
+	fmt.Fprintln(w, "┌───────────────────────────────────────┬───────────────────────────────┬────────────────┬───────┬───────────────────────────────────────────────┐")
+	fmt.Fprintln(w, "│ Endpoint                              │ Path                          │ Kind           │ Count │ Detail                                        │")
+	fmt.Fprintln(w, "├───────────────────────────────────────┼───────────────────────────────┼────────────────┼───────┼───────────────────────────────────────────────┤")
+
+	// Write issues
+	for _, issue := range issues {
+		endpoint := truncate(issue.Endpoint, 37)
+		path := truncate(issue.Path, 29)
+		kind := truncate(string(issue.Kind), 14)
+		count := fmt.Sprintf("%d", issue.Count)
+		detail := truncate(issue.Detail, 45)
+
+		fmt.Fprintf(w, "│ %-37s │ %-29s │ %-14s │ %-5s │ %-45s │\n",
+			endpoint, path, kind, count, detail)
+	}
+
+	fmt.Fprintln(w, "└───────────────────────────────────────┴───────────────────────────────┴────────────────┴───────┴───────────────────────────────────────────────┘")
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mgranderath/rlcs-cli/internal/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoctorTableFormatter_Format(t *testing.T) {
+	formatter := &DoctorTableFormatter{}
+
+	t.Run("no issues", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, formatter.Format(&buf, nil))
+		assert.Equal(t, "No schema issues found\n", buf.String())
+	})
+
+	t.Run("issues", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := formatter.Format(&buf, []schema.Issue{
+			{Endpoint: "/matches/m1/detailed", Path: "$.venue", Kind: schema.UnknownField, Detail: "field is not in the model (string)", Count: 1},
+			{Endpoint: "/games/rl/tournaments/t1/brackets", Path: "$", Kind: schema.RequestFailed, Detail: "tournament not found: t1", Count: 1},
+		})
+		require.NoError(t, err)
+
+		out := buf.String()
+		for _, s := range []string{"Endpoint", "/matches/m1/detailed", "$.venue", "unknown-field", "request-failed", "tournament not found: t1"} {
+			assert.Contains(t, out, s)
+		}
+	})
+}
+
+func TestGetDoctorFormatter(t *testing.T) {
+	for _, format := range []DoctorFormat{DoctorFormatTable, DoctorFormatJSON, DoctorFormatYAML} {
+		formatter, err := GetDoctorFormatter(format)
+		require.NoError(t, err)
+		assert.NotNil(t, formatter)
+	}
+
+	_, err := GetDoctorFormatter("xml")
+	assert.Error(t, err)
+}
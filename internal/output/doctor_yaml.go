@@ -0,0 +1,27 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/schema"
+	"gopkg.in/yaml.v3"
+)
+
+// DoctorYAMLFormatter outputs schema issues as YAML
+type DoctorYAMLFormatter struct{}
+
+func (f *DoctorYAMLFormatter) Format(w io.Writer, issues []schema.Issue) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(issues); err != nil {
+		return fmt.Errorf("failed to encode schema issues to YAML: %w", err)
+	}
+
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to close YAML encoder: %w", err)
+	}
+
+	return nil
+}
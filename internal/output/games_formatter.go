@@ -3,6 +3,7 @@ package output
 import (
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/mgranderath/rlcs-cli/internal/domain"
 )
@@ -21,18 +22,19 @@ const (
 	GamesFormatYAML  GamesFormat = "yaml"
 )
 
-// gamesRegistry holds all registered games formatters
-var gamesRegistry = map[GamesFormat]GamesFormatter{
-	GamesFormatTable: &GamesTableFormatter{},
-	GamesFormatJSON:  &GamesJSONFormatter{},
-	GamesFormatYAML:  &GamesYAMLFormatter{},
+// gamesRegistry holds constructors of all registered games formatters, now returns the
+// current time relative times are computed from
+var gamesRegistry = map[GamesFormat]func(now func() time.Time) GamesFormatter{
+	GamesFormatTable: func(now func() time.Time) GamesFormatter { return &GamesTableFormatter{Now: now} },
+	GamesFormatJSON:  func(func() time.Time) GamesFormatter { return &GamesJSONFormatter{} },
+	GamesFormatYAML:  func(func() time.Time) GamesFormatter { return &GamesYAMLFormatter{} },
 }
 
-// GetGamesFormatter returns the formatter for the given format
-func GetGamesFormatter(format GamesFormat) (GamesFormatter, error) {
-	formatter, ok := gamesRegistry[format]
+// GetGamesFormatter returns the formatter for the given format, now returns the current time relative times are computed from
+func GetGamesFormatter(format GamesFormat, now func() time.Time) (GamesFormatter, error) {
+	newFormatter, ok := gamesRegistry[format]
 	if !ok {
 		return nil, fmt.Errorf("no formatter registered for format: %s", format)
 	}
-	return formatter, nil
+	return newFormatter(now), nil
 }
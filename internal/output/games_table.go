@@ -3,12 +3,16 @@ package output
 import (
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/mgranderath/rlcs-cli/internal/domain"
 )
 
 // GamesTableFormatter outputs games as a simplified ASCII table
-type GamesTableFormatter struct{}
+type GamesTableFormatter struct {
+	// Now returns the current time relative times are computed from, defaults to time.Now
+	Now func() time.Time
+}
 
 func (f *GamesTableFormatter) Format(w io.Writer, games []domain.GameListing) error {
 	if len(games) == 0 {
@@ -17,23 +21,26 @@ func (f *GamesTableFormatter) Format(w io.Writer, games []domain.GameListing) er
 	}
 
 	// Write header
-	fmt.Fprintln(w, "┌───────────────────────┬───────────────────────────────┬─────────────────────────────────────┬─────────┬─────────────┐")
-	fmt.Fprintln(w, "│ Tournament            │ Match                         │ Teams                               │ Score   │ Status      │")
-	fmt.Fprintln(w, "├───────────────────────┼───────────────────────────────┼─────────────────────────────────────┼─────────┼─────────────┤")
+	fmt.Fprintln(w, "┌───────────────────────┬───────────────────────────────┬──────────────────┬─────────────────────┬─────────────────────────────────────┬─────────┬─────────────┐")
+	fmt.Fprintln(w, "│ Tournament            │ Match                         │ Kickoff          │ When                │ Teams                               │ Score   │ Status      │")
+	fmt.Fprintln(w, "├───────────────────────┼───────────────────────────────┼──────────────────┼─────────────────────┼─────────────────────────────────────┼─────────┼─────────────┤")
 
 	// Write games
+	current := currentTime(f.Now)
 	for _, game := range games {
 		tournament := truncate(game.TournamentName, 21)
 		name := truncate(game.Match.Name, 29)
+		kickoff := formatSeriesTime(game.Match.TimeOfSeries)
+		when := formatWhen(game.Match, current)
 		teams := fmt.Sprintf("%s vs %s", truncate(game.Match.TeamA.Name, 15), truncate(game.Match.TeamB.Name, 15))
 		score := fmt.Sprintf("%d - %d", game.Match.TeamAScore, game.Match.TeamBScore)
 		status := formatMatchStatus(game.Match)
 
-		fmt.Fprintf(w, "│ %-21s │ %-29s │ %-35s │ %-7s │ %-11s │\n",
-			tournament, name, teams, score, status)
+		fmt.Fprintf(w, "│ %-21s │ %-29s │ %-16s │ %-19s │ %-35s │ %-7s │ %-11s │\n",
+			tournament, name, kickoff, when, teams, score, status)
 	}
 
-	fmt.Fprintln(w, "└───────────────────────┴───────────────────────────────┴─────────────────────────────────────┴─────────┴─────────────┘")
+	fmt.Fprintln(w, "└───────────────────────┴───────────────────────────────┴──────────────────┴─────────────────────┴─────────────────────────────────────┴─────────┴─────────────┘")
 
 	return nil
 }
@@ -0,0 +1,38 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/teams"
+)
+
+// H2HFormatter defines the interface for head-to-head output formatters
+type H2HFormatter interface {
+	Format(w io.Writer, h2h teams.HeadToHead) error
+}
+
+// H2HFormat represents the output format for head-to-head records
+type H2HFormat string
+
+const (
+	H2HFormatTable H2HFormat = "table"
+	H2HFormatJSON  H2HFormat = "json"
+	H2HFormatYAML  H2HFormat = "yaml"
+)
+
+// h2hRegistry holds all registered head-to-head formatters
+var h2hRegistry = map[H2HFormat]H2HFormatter{
+	H2HFormatTable: &H2HTableFormatter{},
+	H2HFormatJSON:  &H2HJSONFormatter{},
+	H2HFormatYAML:  &H2HYAMLFormatter{},
+}
+
+// GetH2HFormatter returns the formatter for the given format
+func GetH2HFormatter(format H2HFormat) (H2HFormatter, error) {
+	formatter, ok := h2hRegistry[format]
+	if !ok {
+		return nil, fmt.Errorf("no formatter registered for format: %s", format)
+	}
+	return formatter, nil
+}
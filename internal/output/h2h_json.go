@@ -0,0 +1,17 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/teams"
+)
+
+// H2HJSONFormatter outputs a head-to-head record as formatted JSON
+type H2HJSONFormatter struct{}
+
+func (f *H2HJSONFormatter) Format(w io.Writer, h2h teams.HeadToHead) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(h2h)
+}
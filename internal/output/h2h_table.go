@@ -0,0 +1,50 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/teams"
+)
+
+// H2HTableFormatter outputs a head-to-head record as a summary followed by the meetings
+type H2HTableFormatter struct{}
+
+func (f *H2HTableFormatter) Format(w io.Writer, h2h teams.HeadToHead) error {
+	// Write summary
+	fmt.Fprintf(w, "\n%s vs %s\n", h2h.TeamA, h2h.TeamB)
+	fmt.Fprintf(w, "Series: %d-%d\n", h2h.SeriesWinsA, h2h.SeriesWinsB)
+	fmt.Fprintf(w, "Games: %d-%d\n", h2h.GameWinsA, h2h.GameWinsB)
+	if h2h.GamesPlayed > 0 {
+		fmt.Fprintf(w, "Goals: %d-%d (%+.2f per game)\n", h2h.GoalsA, h2h.GoalsB, h2h.GoalDifferentialPerGame)
+	}
+	fmt.Fprintln(w)
+
+	if len(h2h.Meetings) == 0 {
+		fmt.Fprintln(w, "No meetings found")
+		return nil
+	}
+
+	// Write meetings
+	fmt.Fprintln(w, "┌────────────┬───────────────────────────────┬───────────────────────────┬─────────┬─────────┐")
+	fmt.Fprintln(w, "│ Date       │ Tournament                    │ Stage                     │ Score   │ Goals   │")
+	fmt.Fprintln(w, "├────────────┼───────────────────────────────┼───────────────────────────┼─────────┼─────────┤")
+
+	for _, meeting := range h2h.Meetings {
+		date := meeting.Time.Format("2006-01-02")
+		tournament := truncate(meeting.TournamentName, 29)
+		stage := truncate(meeting.Stage, 25)
+		score := fmt.Sprintf("%d - %d", meeting.ScoreA, meeting.ScoreB)
+		goals := "-"
+		if meeting.GoalsA > 0 || meeting.GoalsB > 0 {
+			goals = fmt.Sprintf("%d - %d", meeting.GoalsA, meeting.GoalsB)
+		}
+
+		fmt.Fprintf(w, "│ %-10s │ %-29s │ %-25s │ %-7s │ %-7s │\n",
+			date, tournament, stage, score, goals)
+	}
+
+	fmt.Fprintln(w, "└────────────┴───────────────────────────────┴───────────────────────────┴─────────┴─────────┘")
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/teams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestH2HTableFormatter_Format(t *testing.T) {
+	formatter := &H2HTableFormatter{}
+
+	t.Run("no meetings", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, formatter.Format(&buf, teams.HeadToHead{TeamA: "vit", TeamB: "g2"}))
+		assert.Contains(t, buf.String(), "vit vs g2")
+		assert.Contains(t, buf.String(), "No meetings found")
+		assert.NotContains(t, buf.String(), "Goals")
+	})
+
+	t.Run("meetings", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := formatter.Format(&buf, teams.HeadToHead{
+			TeamA:                   "Team Vitality",
+			TeamB:                   "Karmine Corp",
+			SeriesWinsA:             2,
+			SeriesWinsB:             1,
+			GameWinsA:               9,
+			GameWinsB:               7,
+			GoalsA:                  40,
+			GoalsB:                  32,
+			GamesPlayed:             16,
+			GoalDifferentialPerGame: 0.5,
+			Meetings: []teams.Meeting{
+				{TournamentName: "Major 1", Stage: "Grand Final", Time: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), ScoreA: 4, ScoreB: 3, GoalsA: 18, GoalsB: 15},
+				{TournamentName: "Open 1", Stage: "Semifinal", Time: time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC), ScoreA: 1, ScoreB: 3},
+			},
+		})
+		require.NoError(t, err)
+
+		out := buf.String()
+		for _, s := range []string{"Team Vitality vs Karmine Corp", "Series: 2-1", "Games: 9-7", "Goals: 40-32 (+0.50 per game)", "2026-02-01", "Grand Final", "4 - 3", "18 - 15", "Semifinal"} {
+			assert.Contains(t, out, s)
+		}
+	})
+}
@@ -0,0 +1,27 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/teams"
+	"gopkg.in/yaml.v3"
+)
+
+// H2HYAMLFormatter outputs a head-to-head record as YAML
+type H2HYAMLFormatter struct{}
+
+func (f *H2HYAMLFormatter) Format(w io.Writer, h2h teams.HeadToHead) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(h2h); err != nil {
+		return fmt.Errorf("failed to encode head-to-head to YAML: %w", err)
+	}
+
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to close YAML encoder: %w", err)
+	}
+
+	return nil
+}
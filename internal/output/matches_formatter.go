@@ -3,6 +3,7 @@ package output
 import (
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/mgranderath/rlcs-cli/internal/domain"
 )
@@ -21,18 +22,19 @@ const (
 	MatchesFormatYAML  MatchesFormat = "yaml"
 )
 
-// matchesRegistry holds all registered matches formatters
-var matchesRegistry = map[MatchesFormat]MatchesFormatter{
-	MatchesFormatTable: &MatchesTableFormatter{},
-	MatchesFormatJSON:  &MatchesJSONFormatter{},
-	MatchesFormatYAML:  &MatchesYAMLFormatter{},
+// matchesRegistry holds constructors of all registered matches formatters, now returns the
+// current time relative times are computed from
+var matchesRegistry = map[MatchesFormat]func(now func() time.Time) MatchesFormatter{
+	MatchesFormatTable: func(now func() time.Time) MatchesFormatter { return &MatchesTableFormatter{Now: now} },
+	MatchesFormatJSON:  func(func() time.Time) MatchesFormatter { return &MatchesJSONFormatter{} },
+	MatchesFormatYAML:  func(func() time.Time) MatchesFormatter { return &MatchesYAMLFormatter{} },
 }
 
-// GetMatchesFormatter returns the formatter for the given format
-func GetMatchesFormatter(format MatchesFormat) (MatchesFormatter, error) {
-	formatter, ok := matchesRegistry[format]
+// GetMatchesFormatter returns the formatter for the given format, now returns the current time relative times are computed from
+func GetMatchesFormatter(format MatchesFormat, now func() time.Time) (MatchesFormatter, error) {
+	newFormatter, ok := matchesRegistry[format]
 	if !ok {
 		return nil, fmt.Errorf("no formatter registered for format: %s", format)
 	}
-	return formatter, nil
+	return newFormatter(now), nil
 }
@@ -2,6 +2,7 @@ package output
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -56,7 +57,7 @@ func TestGetMatchesFormatter(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			formatter, err := GetMatchesFormatter(tt.format)
+			formatter, err := GetMatchesFormatter(tt.format, time.Now)
 
 			if tt.expectError {
 				assert.Error(t, err)
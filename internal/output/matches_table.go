@@ -3,12 +3,16 @@ package output
 import (
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/mgranderath/rlcs-cli/internal/domain"
 )
 
 // MatchesTableFormatter outputs matches as a simplified ASCII table
-type MatchesTableFormatter struct{}
+type MatchesTableFormatter struct {
+	// Now returns the current time relative times are computed from, defaults to time.Now
+	Now func() time.Time
+}
 
 func (f *MatchesTableFormatter) Format(w io.Writer, matches []domain.Match) error {
 	if len(matches) == 0 {
@@ -17,22 +21,25 @@ func (f *MatchesTableFormatter) Format(w io.Writer, matches []domain.Match) erro
 	}
 
 	// Write header
-	fmt.Fprintln(w, "┌───────────────────────────────┬─────────────────────────────────────┬─────────┬─────────────┐")
-	fmt.Fprintln(w, "│ Match                         │ Teams                               │ Score   │ Status      │")
-	fmt.Fprintln(w, "├───────────────────────────────┼─────────────────────────────────────┼─────────┼─────────────┤")
+	fmt.Fprintln(w, "┌───────────────────────────────┬──────────────────┬─────────────────────┬─────────────────────────────────────┬─────────┬─────────────┐")
+	fmt.Fprintln(w, "│ Match                         │ Kickoff          │ When                │ Teams                               │ Score   │ Status      │")
+	fmt.Fprintln(w, "├───────────────────────────────┼──────────────────┼─────────────────────┼─────────────────────────────────────┼─────────┼─────────────┤")
 
 	// Write matches
+	current := currentTime(f.Now)
 	for _, match := range matches {
 		name := truncate(match.Name, 29)
+		kickoff := formatSeriesTime(match.TimeOfSeries)
+		when := formatWhen(match, current)
 		teams := fmt.Sprintf("%s vs %s", truncate(match.TeamA.Name, 15), truncate(match.TeamB.Name, 15))
 		score := fmt.Sprintf("%d - %d", match.TeamAScore, match.TeamBScore)
 		status := f.formatStatus(match)
 
-		fmt.Fprintf(w, "│ %-29s │ %-35s │ %-7s │ %-11s │\n",
-			name, teams, score, status)
+		fmt.Fprintf(w, "│ %-29s │ %-16s │ %-19s │ %-35s │ %-7s │ %-11s │\n",
+			name, kickoff, when, teams, score, status)
 	}
 
-	fmt.Fprintln(w, "└───────────────────────────────┴─────────────────────────────────────┴─────────┴─────────────┘")
+	fmt.Fprintln(w, "└───────────────────────────────┴──────────────────┴─────────────────────┴─────────────────────────────────────┴─────────┴─────────────┘")
 
 	return nil
 }
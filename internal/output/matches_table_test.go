@@ -34,7 +34,7 @@ func TestMatchesTableFormatter_Format(t *testing.T) {
 					TimeOfSeries: time.Date(2026, 1, 15, 18, 0, 0, 0, time.UTC),
 				},
 			},
-			contains: []string{"Grand Final", "Vitality vs KC", "4 - 2", "Completed"},
+			contains: []string{"Grand Final", "2026-01-15 18:00", "Vitality vs KC", "4 - 2", "Completed"},
 		},
 		{
 			name: "multiple matches",
@@ -0,0 +1,47 @@
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/graph"
+)
+
+// PathCSVFormatter outputs the series of a path to the title as CSV, one row per series
+type PathCSVFormatter struct{}
+
+func (f *PathCSVFormatter) Format(w io.Writer, path graph.Path) error {
+	writer := csv.NewWriter(w)
+	defer writer.Flush()
+
+	// Write header
+	header := []string{"Step", "TeamUUID", "Team", "Bracket", "SeriesUUID", "Series", "Type", "Time", "Live", "Opponent", "IfWon", "IfLost", "DropsTo"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	// Write rows
+	for i, step := range path.Steps {
+		record := []string{
+			fmt.Sprintf("%d", i+1),
+			path.TeamUUID,
+			path.Team,
+			step.Bracket,
+			step.SeriesUUID,
+			step.Series,
+			step.Type,
+			step.Time.Format("2006-01-02T15:04:05Z07:00"),
+			fmt.Sprintf("%t", step.Live),
+			step.Opponent,
+			step.IfWon,
+			step.IfLost,
+			step.DropsTo,
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write CSV record: %w", err)
+		}
+	}
+
+	return nil
+}
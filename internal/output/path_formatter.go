@@ -0,0 +1,30 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/graph"
+)
+
+// PathFormatter defines the interface for path-to-title output formatters
+type PathFormatter interface {
+	Format(w io.Writer, path graph.Path) error
+}
+
+// pathRegistry holds all registered path formatters
+var pathRegistry = map[Format]PathFormatter{
+	FormatTable: &PathTableFormatter{},
+	FormatJSON:  &PathJSONFormatter{},
+	FormatCSV:   &PathCSVFormatter{},
+	FormatYAML:  &PathYAMLFormatter{},
+}
+
+// GetPathFormatter returns the formatter for the given format
+func GetPathFormatter(format Format) (PathFormatter, error) {
+	formatter, ok := pathRegistry[format]
+	if !ok {
+		return nil, fmt.Errorf("no formatter registered for format: %s", format)
+	}
+	return formatter, nil
+}
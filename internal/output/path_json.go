@@ -0,0 +1,17 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/graph"
+)
+
+// PathJSONFormatter outputs a path to the title as formatted JSON
+type PathJSONFormatter struct{}
+
+func (f *PathJSONFormatter) Format(w io.Writer, path graph.Path) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(path)
+}
@@ -0,0 +1,57 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/graph"
+)
+
+// PathTableFormatter outputs a path to the title as a summary followed by the series the team still has to win
+type PathTableFormatter struct{}
+
+func (f *PathTableFormatter) Format(w io.Writer, path graph.Path) error {
+	// Write summary
+	fmt.Fprintf(w, "\n%s", path.Team)
+	if path.Shorthand != "" {
+		fmt.Fprintf(w, " (%s)", path.Shorthand)
+	}
+	fmt.Fprintln(w)
+
+	switch {
+	case path.Status == graph.StatusWinner:
+		fmt.Fprintf(w, "Won the tournament in %s\n", path.Series)
+		return nil
+	case path.Status == graph.StatusEliminated:
+		fmt.Fprintf(w, "Eliminated in %s\n", path.Series)
+		return nil
+	case len(path.Steps) == 0:
+		fmt.Fprintf(w, "No series linked after %s\n", path.Series)
+		return nil
+	}
+	fmt.Fprintf(w, "Series to win: %d\n\n", len(path.Steps))
+
+	// Write steps
+	fmt.Fprintln(w, "┌────┬──────────────────────────────┬──────────────────────────────┬──────────────────┬──────────────────────────────┬──────────────────────────────┐")
+	fmt.Fprintln(w, "│ #  │ Series                       │ Opponent                     │ Kickoff          │ If won                       │ If lost                      │")
+	fmt.Fprintln(w, "├────┼──────────────────────────────┼──────────────────────────────┼──────────────────┼──────────────────────────────┼──────────────────────────────┤")
+
+	for i, step := range path.Steps {
+		series := step.Series
+		if step.Bracket != "" {
+			series = step.Bracket + ": " + series
+		}
+
+		fmt.Fprintf(w, "│ %-2d │ %-28s │ %-28s │ %-16s │ %-28s │ %-28s │\n",
+			i+1, truncate(series, 28), truncate(step.Opponent, 28), formatKickoff(step),
+			truncate(step.IfWon, 28), truncate(step.IfLost, 28))
+	}
+
+	fmt.Fprintln(w, "└────┴──────────────────────────────┴──────────────────────────────┴──────────────────┴──────────────────────────────┴──────────────────────────────┘")
+
+	if !path.Complete {
+		fmt.Fprintln(w, "The path is not linked beyond the last series")
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/graph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPath() graph.Path {
+	return graph.Path{
+		TeamUUID:  "flcn",
+		Team:      "Team Falcons",
+		Shorthand: "FLCN",
+		Status:    graph.StatusAlive,
+		Complete:  true,
+		Steps: []graph.Step{
+			{Bracket: "Playoffs", SeriesUUID: "p-sf2", Series: "Semifinal 2", Type: "BO7", Live: true, TeamScore: 1, OpponentScore: 1, Opponent: "Spacestation Gaming", IfWon: "Playoffs: Grand Final", IfLost: "Eliminated"},
+			{Bracket: "Playoffs", SeriesUUID: "p-final", Series: "Grand Final", Type: "BO7", Time: time.Date(2026, 3, 5, 18, 0, 0, 0, time.UTC), Opponent: "Team Vitality", IfWon: "Wins the tournament", IfLost: "Eliminated"},
+		},
+	}
+}
+
+func TestPathTableFormatter_Format(t *testing.T) {
+	formatter := &PathTableFormatter{}
+
+	t.Run("alive", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, formatter.Format(&buf, testPath()))
+
+		out := buf.String()
+		for _, s := range []string{"Team Falcons (FLCN)", "Series to win: 2", "Playoffs: Semifinal 2", "Kickoff", "LIVE 1-1", "2026-03-05 18:00", "Wins the tournament"} {
+			assert.Contains(t, out, s)
+		}
+		assert.NotContains(t, out, "not linked")
+	})
+
+	t.Run("not linked", func(t *testing.T) {
+		path := testPath()
+		path.Complete = false
+
+		var buf bytes.Buffer
+		require.NoError(t, formatter.Format(&buf, path))
+		assert.Contains(t, buf.String(), "The path is not linked beyond the last series")
+	})
+
+	t.Run("finished", func(t *testing.T) {
+		path := graph.Path{Team: "Team Vitality", Status: graph.StatusWinner, Series: "Playoffs: Grand Final"}
+
+		var buf bytes.Buffer
+		require.NoError(t, formatter.Format(&buf, path))
+		assert.Equal(t, "\nTeam Vitality\nWon the tournament in Playoffs: Grand Final\n", buf.String())
+
+		path.Status = graph.StatusAlive
+		path.Series = "Swiss: Round 1"
+		buf.Reset()
+		require.NoError(t, formatter.Format(&buf, path))
+		assert.Contains(t, buf.String(), "No series linked after Swiss: Round 1")
+	})
+}
+
+func TestPathCSVFormatter_Format(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, (&PathCSVFormatter{}).Format(&buf, testPath()))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, "Step", records[0][0])
+	assert.Equal(t, []string{"2", "flcn", "Team Falcons", "Playoffs", "p-final", "Grand Final", "BO7", "2026-03-05T18:00:00Z", "false", "Team Vitality", "Wins the tournament", "Eliminated", ""}, records[2])
+}
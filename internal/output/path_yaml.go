@@ -0,0 +1,27 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/graph"
+	"gopkg.in/yaml.v3"
+)
+
+// PathYAMLFormatter outputs a path to the title as YAML
+type PathYAMLFormatter struct{}
+
+func (f *PathYAMLFormatter) Format(w io.Writer, path graph.Path) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(path); err != nil {
+		return fmt.Errorf("failed to encode path to YAML: %w", err)
+	}
+
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to close YAML encoder: %w", err)
+	}
+
+	return nil
+}
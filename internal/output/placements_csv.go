@@ -0,0 +1,43 @@
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/placements"
+)
+
+// PlacementsCSVFormatter outputs placements as CSV
+type PlacementsCSVFormatter struct{}
+
+func (f *PlacementsCSVFormatter) Format(w io.Writer, results []placements.Placement) error {
+	writer := csv.NewWriter(w)
+	defer writer.Flush()
+
+	// Write header
+	header := []string{"From", "To", "Placement", "TeamUUID", "Team", "Shorthand", "Status", "Bracket", "Stage"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	// Write rows
+	for _, p := range results {
+		record := []string{
+			fmt.Sprintf("%d", p.From),
+			fmt.Sprintf("%d", p.To),
+			p.Placement,
+			p.TeamUUID,
+			p.Team,
+			p.Shorthand,
+			string(p.Status),
+			p.Bracket,
+			p.Stage,
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write CSV record: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/placements"
+)
+
+// PlacementsFormatter defines the interface for placement output formatters
+type PlacementsFormatter interface {
+	Format(w io.Writer, results []placements.Placement) error
+}
+
+// placementsRegistry holds all registered placement formatters
+var placementsRegistry = map[Format]PlacementsFormatter{
+	FormatTable: &PlacementsTableFormatter{},
+	FormatJSON:  &PlacementsJSONFormatter{},
+	FormatCSV:   &PlacementsCSVFormatter{},
+	FormatYAML:  &PlacementsYAMLFormatter{},
+}
+
+// GetPlacementsFormatter returns the formatter for the given format
+func GetPlacementsFormatter(format Format) (PlacementsFormatter, error) {
+	formatter, ok := placementsRegistry[format]
+	if !ok {
+		return nil, fmt.Errorf("no formatter registered for format: %s", format)
+	}
+	return formatter, nil
+}
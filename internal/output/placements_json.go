@@ -0,0 +1,17 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/placements"
+)
+
+// PlacementsJSONFormatter outputs placements as formatted JSON
+type PlacementsJSONFormatter struct{}
+
+func (f *PlacementsJSONFormatter) Format(w io.Writer, results []placements.Placement) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(results)
+}
@@ -0,0 +1,38 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/placements"
+)
+
+// PlacementsTableFormatter outputs placements as an ASCII table
+type PlacementsTableFormatter struct{}
+
+func (f *PlacementsTableFormatter) Format(w io.Writer, results []placements.Placement) error {
+	if len(results) == 0 {
+		fmt.Fprintln(w, "No placements found")
+		return nil
+	}
+
+	// Write header
+	fmt.Fprintln(w, "┌─────────────┬──────────────────────────┬───────┬────────────┬───────────────────────┬───────────────────────┐")
+	fmt.Fprintln(w, "│ Placement   │ Team                     │ Tag   │ Status     │ Bracket               │ Stage                 │")
+	fmt.Fprintln(w, "├─────────────┼──────────────────────────┼───────┼────────────┼───────────────────────┼───────────────────────┤")
+
+	// Write placements
+	for _, p := range results {
+		team := truncate(p.Team, 24)
+		tag := truncate(p.Shorthand, 5)
+		bracket := truncate(p.Bracket, 21)
+		stage := truncate(p.Stage, 21)
+
+		fmt.Fprintf(w, "│ %-11s │ %-24s │ %-5s │ %-10s │ %-21s │ %-21s │\n",
+			p.Placement, team, tag, p.Status, bracket, stage)
+	}
+
+	fmt.Fprintln(w, "└─────────────┴──────────────────────────┴───────┴────────────┴───────────────────────┴───────────────────────┘")
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/mgranderath/rlcs-cli/internal/placements"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPlacements() []placements.Placement {
+	return []placements.Placement{
+		{From: 1, To: 1, Placement: "1st", TeamUUID: "vit", Team: "Team Vitality", Shorthand: "VIT", Status: placements.StatusWinner, Bracket: "Playoffs", Stage: "Grand Final"},
+		{From: 3, To: 4, Placement: "3rd–4th", TeamUUID: "fur", Team: "FURIA Esports", Shorthand: "FUR", Status: placements.StatusEliminated, Bracket: "Playoffs", Stage: "Semifinal 1"},
+	}
+}
+
+func TestPlacementsTableFormatter_Format(t *testing.T) {
+	formatter := &PlacementsTableFormatter{}
+
+	t.Run("no placements", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, formatter.Format(&buf, nil))
+		assert.Equal(t, "No placements found\n", buf.String())
+	})
+
+	t.Run("placements", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, formatter.Format(&buf, testPlacements()))
+
+		out := buf.String()
+		for _, s := range []string{"Placement", "1st", "3rd–4th", "Team Vitality", "VIT", "Winner", "Eliminated", "Semifinal 1"} {
+			assert.Contains(t, out, s)
+		}
+	})
+}
+
+func TestPlacementsCSVFormatter_Format(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, (&PlacementsCSVFormatter{}).Format(&buf, testPlacements()))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, "From", records[0][0])
+	assert.Equal(t, []string{"3", "4", "3rd–4th", "fur", "FURIA Esports", "FUR", "Eliminated", "Playoffs", "Semifinal 1"}, records[2])
+}
+
+func TestGetPlacementsFormatter(t *testing.T) {
+	for _, format := range []Format{FormatTable, FormatJSON, FormatCSV, FormatYAML} {
+		formatter, err := GetPlacementsFormatter(format)
+		require.NoError(t, err)
+		assert.NotNil(t, formatter)
+	}
+
+	_, err := GetPlacementsFormatter("xml")
+	assert.Error(t, err)
+}
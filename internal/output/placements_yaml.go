@@ -0,0 +1,27 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/placements"
+	"gopkg.in/yaml.v3"
+)
+
+// PlacementsYAMLFormatter outputs placements as YAML
+type PlacementsYAMLFormatter struct{}
+
+func (f *PlacementsYAMLFormatter) Format(w io.Writer, results []placements.Placement) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(results); err != nil {
+		return fmt.Errorf("failed to encode placements to YAML: %w", err)
+	}
+
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to close YAML encoder: %w", err)
+	}
+
+	return nil
+}
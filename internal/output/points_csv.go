@@ -0,0 +1,47 @@
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/points"
+)
+
+// PointsCSVFormatter outputs a circuit points leaderboard as CSV with one row per team and event
+type PointsCSVFormatter struct{}
+
+func (f *PointsCSVFormatter) Format(w io.Writer, standings []points.Standing) error {
+	writer := csv.NewWriter(w)
+	defer writer.Flush()
+
+	// Write header
+	header := []string{"Rank", "TeamUUID", "Team", "Shorthand", "TotalPoints", "TournamentID", "Tournament", "Type", "Placement", "Points", "Provisional"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	// Write rows
+	for _, s := range standings {
+		for _, event := range s.Events {
+			record := []string{
+				fmt.Sprintf("%d", s.Rank),
+				s.TeamUUID,
+				s.Team,
+				s.Shorthand,
+				fmt.Sprintf("%d", s.Points),
+				event.TournamentID,
+				event.TournamentName,
+				string(event.Type),
+				event.Placement,
+				fmt.Sprintf("%d", event.Points),
+				fmt.Sprintf("%t", event.Provisional),
+			}
+			if err := writer.Write(record); err != nil {
+				return fmt.Errorf("failed to write CSV record: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
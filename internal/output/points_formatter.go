@@ -0,0 +1,30 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/points"
+)
+
+// PointsFormatter defines the interface for circuit points leaderboard output formatters
+type PointsFormatter interface {
+	Format(w io.Writer, standings []points.Standing) error
+}
+
+// pointsRegistry holds all registered circuit points formatters
+var pointsRegistry = map[Format]PointsFormatter{
+	FormatTable: &PointsTableFormatter{},
+	FormatJSON:  &PointsJSONFormatter{},
+	FormatCSV:   &PointsCSVFormatter{},
+	FormatYAML:  &PointsYAMLFormatter{},
+}
+
+// GetPointsFormatter returns the formatter for the given format
+func GetPointsFormatter(format Format) (PointsFormatter, error) {
+	formatter, ok := pointsRegistry[format]
+	if !ok {
+		return nil, fmt.Errorf("no formatter registered for format: %s", format)
+	}
+	return formatter, nil
+}
@@ -0,0 +1,17 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/points"
+)
+
+// PointsJSONFormatter outputs a circuit points leaderboard as formatted JSON
+type PointsJSONFormatter struct{}
+
+func (f *PointsJSONFormatter) Format(w io.Writer, standings []points.Standing) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(standings)
+}
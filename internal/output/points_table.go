@@ -0,0 +1,68 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/points"
+)
+
+// PointsTableFormatter outputs a circuit points leaderboard as an ASCII table
+// with the events of each team listed below its total
+type PointsTableFormatter struct{}
+
+func (f *PointsTableFormatter) Format(w io.Writer, standings []points.Standing) error {
+	if len(standings) == 0 {
+		fmt.Fprintln(w, "No circuit points found")
+		return nil
+	}
+
+	// Write header
+	fmt.Fprintln(w, "┌─────┬──────────────────────────┬───────┬────────┬────────────────────────────────┬─────────────┬────────┐")
+	fmt.Fprintln(w, "│ #   │ Team                     │ Tag   │ Total  │ Event                          │ Placement   │ Points │")
+	fmt.Fprintln(w, "├─────┼──────────────────────────┼───────┼────────┼────────────────────────────────┼─────────────┼────────┤")
+
+	// Write standings, the first event shares the row of the team
+	provisional := false
+	for _, s := range standings {
+		rank := fmt.Sprintf("%d", s.Rank)
+		team := truncate(s.Team, 24)
+		tag := truncate(s.Shorthand, 5)
+		total := formatPoints(s.Points, s.Provisional)
+		provisional = provisional || s.Provisional
+
+		events := s.Events
+		if len(events) == 0 {
+			events = []points.Event{{}}
+		}
+		for i, event := range events {
+			if i > 0 {
+				rank, team, tag, total = "", "", "", ""
+			}
+			name := truncate(event.TournamentName, 30)
+			eventPoints := ""
+			if event.TournamentID != "" {
+				eventPoints = formatPoints(event.Points, event.Provisional)
+			}
+
+			fmt.Fprintf(w, "│ %-3s │ %-24s │ %-5s │ %6s │ %-30s │ %-11s │ %6s │\n",
+				rank, team, tag, total, name, event.Placement, eventPoints)
+		}
+	}
+
+	fmt.Fprintln(w, "└─────┴──────────────────────────┴───────┴────────┴────────────────────────────────┴─────────────┴────────┘")
+
+	if provisional {
+		fmt.Fprintln(w, "* provisional, the team is still playing and is awarded the points it is guaranteed")
+	}
+
+	return nil
+}
+
+// formatPoints formats points and marks provisional ones with an asterisk
+func formatPoints(value int, provisional bool) string {
+	if provisional {
+		return fmt.Sprintf("%d*", value)
+	}
+	return fmt.Sprintf("%d", value)
+}
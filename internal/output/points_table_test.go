@@ -0,0 +1,75 @@
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/points"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPoints() []points.Standing {
+	return []points.Standing{
+		{
+			Rank: 1, TeamUUID: "vit", Team: "Team Vitality", Shorthand: "VIT", Points: 400, Provisional: true,
+			Events: []points.Event{
+				{TournamentID: "open-1", TournamentName: "RLCS 2026 EU Open 1", Type: domain.TypeOpen, Placement: "1st", Points: 220},
+				{TournamentID: "major-1", TournamentName: "RLCS 2026 Major 1", Type: domain.TypeMajor, Placement: "1st–4th", Points: 180, Provisional: true},
+			},
+		},
+		{
+			Rank: 2, TeamUUID: "kc", Team: "Karmine Corp", Shorthand: "KC", Points: 150,
+			Events: []points.Event{
+				{TournamentID: "open-1", TournamentName: "RLCS 2026 EU Open 1", Type: domain.TypeOpen, Placement: "2nd", Points: 150},
+			},
+		},
+	}
+}
+
+func TestPointsTableFormatter_Format(t *testing.T) {
+	formatter := &PointsTableFormatter{}
+
+	t.Run("no standings", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, formatter.Format(&buf, nil))
+		assert.Equal(t, "No circuit points found\n", buf.String())
+	})
+
+	t.Run("standings", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, formatter.Format(&buf, testPoints()))
+
+		out := buf.String()
+		for _, s := range []string{"Team Vitality", "400*", "RLCS 2026 EU Open 1", "1st–4th", "180*", "Karmine Corp", "150", "* provisional"} {
+			assert.Contains(t, out, s)
+		}
+		// One row per event plus the borders, the header and the footnote
+		assert.Len(t, bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n")), 3+3+1+1)
+	})
+}
+
+func TestPointsCSVFormatter_Format(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, (&PointsCSVFormatter{}).Format(&buf, testPoints()))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+	assert.Equal(t, "Rank", records[0][0])
+	assert.Equal(t, []string{"1", "vit", "Team Vitality", "VIT", "400", "major-1", "RLCS 2026 Major 1", "Major", "1st–4th", "180", "true"}, records[2])
+	assert.Equal(t, "kc", records[3][1])
+}
+
+func TestGetPointsFormatter(t *testing.T) {
+	for _, format := range []Format{FormatTable, FormatJSON, FormatCSV, FormatYAML} {
+		formatter, err := GetPointsFormatter(format)
+		require.NoError(t, err)
+		assert.NotNil(t, formatter)
+	}
+
+	_, err := GetPointsFormatter("xml")
+	assert.Error(t, err)
+}
@@ -0,0 +1,27 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/points"
+	"gopkg.in/yaml.v3"
+)
+
+// PointsYAMLFormatter outputs a circuit points leaderboard as YAML
+type PointsYAMLFormatter struct{}
+
+func (f *PointsYAMLFormatter) Format(w io.Writer, standings []points.Standing) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(standings); err != nil {
+		return fmt.Errorf("failed to encode circuit points to YAML: %w", err)
+	}
+
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to close YAML encoder: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/ratings"
+)
+
+// RatingsCSVFormatter outputs team ratings as CSV
+type RatingsCSVFormatter struct{}
+
+func (f *RatingsCSVFormatter) Format(w io.Writer, records []ratings.Rating) error {
+	writer := csv.NewWriter(w)
+	defer writer.Flush()
+
+	// Write header
+	header := []string{"Rank", "TeamUUID", "Team", "Shorthand", "Region", "Rating", "Deviation", "Volatility", "SeriesWins", "SeriesLosses", "GameWins", "GameLosses", "LastPlayed"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	// Write rows
+	for _, r := range records {
+		record := []string{
+			fmt.Sprintf("%d", r.Rank),
+			r.TeamUUID,
+			r.Team,
+			r.Shorthand,
+			string(r.Region),
+			fmt.Sprintf("%.1f", r.Rating),
+			fmt.Sprintf("%.1f", r.Deviation),
+			fmt.Sprintf("%.6f", r.Volatility),
+			fmt.Sprintf("%d", r.SeriesWins),
+			fmt.Sprintf("%d", r.SeriesLosses),
+			fmt.Sprintf("%d", r.GameWins),
+			fmt.Sprintf("%d", r.GameLosses),
+			r.LastPlayed.Format("2006-01-02"),
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write CSV record: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// RatingHistoryCSVFormatter outputs the rating history of a team as CSV
+type RatingHistoryCSVFormatter struct{}
+
+func (f *RatingHistoryCSVFormatter) Format(w io.Writer, rating ratings.Rating) error {
+	writer := csv.NewWriter(w)
+	defer writer.Flush()
+
+	// Write header
+	header := []string{"Time", "TournamentID", "Tournament", "MatchUUID", "Stage", "Opponent", "OpponentRating", "Score", "OpponentScore", "Won", "Before", "After"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	// Write rows
+	for _, change := range rating.History {
+		record := []string{
+			change.Time.Format("2006-01-02T15:04:05Z07:00"),
+			change.TournamentID,
+			change.TournamentName,
+			change.MatchUUID,
+			change.Stage,
+			change.Opponent,
+			fmt.Sprintf("%.1f", change.OpponentRating),
+			fmt.Sprintf("%d", change.Score),
+			fmt.Sprintf("%d", change.OpponentScore),
+			fmt.Sprintf("%t", change.Won),
+			fmt.Sprintf("%.1f", change.Before),
+			fmt.Sprintf("%.1f", change.After),
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write CSV record: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// RatingsExportCSVFormatter outputs the ratings of an export as CSV, the options are not included
+type RatingsExportCSVFormatter struct{}
+
+func (f *RatingsExportCSVFormatter) Format(w io.Writer, export ratings.Export) error {
+	return (&RatingsCSVFormatter{}).Format(w, export.Ratings)
+}
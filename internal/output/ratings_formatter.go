@@ -0,0 +1,73 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/ratings"
+)
+
+// RatingsFormatter defines the interface for team ratings output formatters
+type RatingsFormatter interface {
+	Format(w io.Writer, records []ratings.Rating) error
+}
+
+// RatingHistoryFormatter defines the interface for the rating history of a single team
+type RatingHistoryFormatter interface {
+	Format(w io.Writer, rating ratings.Rating) error
+}
+
+// RatingsExportFormatter defines the interface for ratings export formatters
+type RatingsExportFormatter interface {
+	Format(w io.Writer, export ratings.Export) error
+}
+
+// ratingsRegistry holds all registered ratings formatters
+var ratingsRegistry = map[Format]RatingsFormatter{
+	FormatTable: &RatingsTableFormatter{},
+	FormatJSON:  &RatingsJSONFormatter{},
+	FormatCSV:   &RatingsCSVFormatter{},
+	FormatYAML:  &RatingsYAMLFormatter{},
+}
+
+// ratingHistoryRegistry holds all registered rating history formatters
+var ratingHistoryRegistry = map[Format]RatingHistoryFormatter{
+	FormatTable: &RatingHistoryTableFormatter{},
+	FormatJSON:  &RatingHistoryJSONFormatter{},
+	FormatCSV:   &RatingHistoryCSVFormatter{},
+	FormatYAML:  &RatingHistoryYAMLFormatter{},
+}
+
+// ratingsExportRegistry holds all registered ratings export formatters, exports are meant for other tools
+var ratingsExportRegistry = map[Format]RatingsExportFormatter{
+	FormatJSON: &RatingsExportJSONFormatter{},
+	FormatCSV:  &RatingsExportCSVFormatter{},
+	FormatYAML: &RatingsExportYAMLFormatter{},
+}
+
+// GetRatingsFormatter returns the ratings formatter for the given format
+func GetRatingsFormatter(format Format) (RatingsFormatter, error) {
+	formatter, ok := ratingsRegistry[format]
+	if !ok {
+		return nil, fmt.Errorf("no formatter registered for format: %s", format)
+	}
+	return formatter, nil
+}
+
+// GetRatingHistoryFormatter returns the rating history formatter for the given format
+func GetRatingHistoryFormatter(format Format) (RatingHistoryFormatter, error) {
+	formatter, ok := ratingHistoryRegistry[format]
+	if !ok {
+		return nil, fmt.Errorf("no formatter registered for format: %s", format)
+	}
+	return formatter, nil
+}
+
+// GetRatingsExportFormatter returns the ratings export formatter for the given format
+func GetRatingsExportFormatter(format Format) (RatingsExportFormatter, error) {
+	formatter, ok := ratingsExportRegistry[format]
+	if !ok {
+		return nil, fmt.Errorf("no formatter registered for format: %s", format)
+	}
+	return formatter, nil
+}
@@ -0,0 +1,35 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/ratings"
+)
+
+// RatingsJSONFormatter outputs team ratings as formatted JSON
+type RatingsJSONFormatter struct{}
+
+func (f *RatingsJSONFormatter) Format(w io.Writer, records []ratings.Rating) error {
+	return encodeRatingsJSON(w, records)
+}
+
+// RatingHistoryJSONFormatter outputs the rating history of a team as formatted JSON
+type RatingHistoryJSONFormatter struct{}
+
+func (f *RatingHistoryJSONFormatter) Format(w io.Writer, rating ratings.Rating) error {
+	return encodeRatingsJSON(w, rating)
+}
+
+// RatingsExportJSONFormatter outputs a ratings export as formatted JSON
+type RatingsExportJSONFormatter struct{}
+
+func (f *RatingsExportJSONFormatter) Format(w io.Writer, export ratings.Export) error {
+	return encodeRatingsJSON(w, export)
+}
+
+func encodeRatingsJSON(w io.Writer, v any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
@@ -0,0 +1,89 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/ratings"
+)
+
+// RatingsTableFormatter outputs team ratings as an ASCII table
+type RatingsTableFormatter struct{}
+
+func (f *RatingsTableFormatter) Format(w io.Writer, records []ratings.Rating) error {
+	if len(records) == 0 {
+		fmt.Fprintln(w, "No ratings found")
+		return nil
+	}
+
+	// Write header
+	fmt.Fprintln(w, "┌─────┬──────────────────────────┬───────┬─────────┬───────┬─────────┬─────────┬─────────────┐")
+	fmt.Fprintln(w, "│ #   │ Team                     │ Tag   │ Rating  │ RD    │ Series  │ Games   │ Last Played │")
+	fmt.Fprintln(w, "├─────┼──────────────────────────┼───────┼─────────┼───────┼─────────┼─────────┼─────────────┤")
+
+	// Write ratings
+	for _, r := range records {
+		rank := fmt.Sprintf("%d", r.Rank)
+		team := truncate(r.Team, 24)
+		tag := truncate(r.Shorthand, 5)
+		rating := fmt.Sprintf("%.0f", r.Rating)
+		deviation := "-"
+		if r.Deviation > 0 {
+			deviation = fmt.Sprintf("%.0f", r.Deviation)
+		}
+		series := fmt.Sprintf("%d-%d", r.SeriesWins, r.SeriesLosses)
+		games := fmt.Sprintf("%d-%d", r.GameWins, r.GameLosses)
+		lastPlayed := r.LastPlayed.Format("2006-01-02")
+
+		fmt.Fprintf(w, "│ %-3s │ %-24s │ %-5s │ %7s │ %5s │ %-7s │ %-7s │ %-11s │\n",
+			rank, team, tag, rating, deviation, series, games, lastPlayed)
+	}
+
+	fmt.Fprintln(w, "└─────┴──────────────────────────┴───────┴─────────┴───────┴─────────┴─────────┴─────────────┘")
+
+	return nil
+}
+
+// RatingHistoryTableFormatter outputs the rating history of a team as a summary followed by its series
+type RatingHistoryTableFormatter struct{}
+
+func (f *RatingHistoryTableFormatter) Format(w io.Writer, rating ratings.Rating) error {
+	// Write summary
+	fmt.Fprintf(w, "\n%s", rating.Team)
+	if rating.Shorthand != "" {
+		fmt.Fprintf(w, " (%s)", rating.Shorthand)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "Rating: %.0f", rating.Rating)
+	if rating.Deviation > 0 {
+		fmt.Fprintf(w, " ± %.0f", rating.Deviation)
+	}
+	fmt.Fprintf(w, " (#%d)\n", rating.Rank)
+	fmt.Fprintf(w, "Series: %d-%d, Games: %d-%d\n\n", rating.SeriesWins, rating.SeriesLosses, rating.GameWins, rating.GameLosses)
+
+	if len(rating.History) == 0 {
+		fmt.Fprintln(w, "No rated series found")
+		return nil
+	}
+
+	// Write history
+	fmt.Fprintln(w, "┌────────────┬───────────────────────────────┬──────────────────────────┬─────────┬─────────┬─────────┐")
+	fmt.Fprintln(w, "│ Date       │ Tournament                    │ Opponent                 │ Score   │ Rating  │ Change  │")
+	fmt.Fprintln(w, "├────────────┼───────────────────────────────┼──────────────────────────┼─────────┼─────────┼─────────┤")
+
+	for _, change := range rating.History {
+		date := change.Time.Format("2006-01-02")
+		tournament := truncate(change.TournamentName, 29)
+		opponent := truncate(fmt.Sprintf("%s (%.0f)", change.Opponent, change.OpponentRating), 24)
+		score := fmt.Sprintf("%d - %d", change.Score, change.OpponentScore)
+		after := fmt.Sprintf("%.0f", change.After)
+		delta := fmt.Sprintf("%+.1f", change.After-change.Before)
+
+		fmt.Fprintf(w, "│ %-10s │ %-29s │ %-24s │ %-7s │ %7s │ %7s │\n",
+			date, tournament, opponent, score, after, delta)
+	}
+
+	fmt.Fprintln(w, "└────────────┴───────────────────────────────┴──────────────────────────┴─────────┴─────────┴─────────┘")
+
+	return nil
+}
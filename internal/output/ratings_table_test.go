@@ -0,0 +1,113 @@
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/ratings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRatings() []ratings.Rating {
+	played := time.Date(2026, 3, 4, 14, 0, 0, 0, time.UTC)
+	return []ratings.Rating{
+		{
+			Rank: 1, TeamUUID: "vit", Team: "Team Vitality", Shorthand: "VIT", Rating: 1546.53,
+			SeriesWins: 3, GameWins: 10, GameLosses: 3, LastPlayed: played,
+			History: []ratings.Change{
+				{Time: played, TournamentID: "major", TournamentName: "RLCS 2026 Major 1", MatchUUID: "p-sf1", Stage: "Semifinal 1", Opponent: "FURIA Esports", OpponentRating: 1500, Score: 4, OpponentScore: 2, Won: true, Before: 1532, After: 1546.53},
+			},
+		},
+		{Rank: 2, TeamUUID: "kc", Team: "Karmine Corp", Shorthand: "KC", Rating: 1468.2, Deviation: 112.4, Volatility: 0.06, SeriesWins: 1, SeriesLosses: 2, LastPlayed: played},
+	}
+}
+
+func TestRatingsTableFormatter_Format(t *testing.T) {
+	formatter := &RatingsTableFormatter{}
+
+	t.Run("no ratings", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, formatter.Format(&buf, nil))
+		assert.Equal(t, "No ratings found\n", buf.String())
+	})
+
+	t.Run("ratings", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, formatter.Format(&buf, testRatings()))
+
+		out := buf.String()
+		for _, s := range []string{"Team Vitality", "1547", "3-0", "10-3", "2026-03-04", "Karmine Corp", "1468", "112"} {
+			assert.Contains(t, out, s)
+		}
+	})
+}
+
+func TestRatingHistoryTableFormatter_Format(t *testing.T) {
+	formatter := &RatingHistoryTableFormatter{}
+
+	var buf bytes.Buffer
+	require.NoError(t, formatter.Format(&buf, testRatings()[0]))
+	out := buf.String()
+	for _, s := range []string{"Team Vitality (VIT)", "Rating: 1547 (#1)", "Series: 3-0, Games: 10-3", "FURIA Esports (1500)", "4 - 2", "+14.5"} {
+		assert.Contains(t, out, s)
+	}
+
+	buf.Reset()
+	require.NoError(t, formatter.Format(&buf, testRatings()[1]))
+	assert.Contains(t, buf.String(), "Rating: 1468 ± 112 (#2)")
+	assert.Contains(t, buf.String(), "No rated series found")
+}
+
+func TestRatingsCSVFormatter_Format(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, (&RatingsCSVFormatter{}).Format(&buf, testRatings()))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, "Rank", records[0][0])
+	assert.Equal(t, []string{"1", "vit", "Team Vitality", "VIT", "", "1546.5", "0.0", "0.000000", "3", "0", "10", "3", "2026-03-04"}, records[1])
+
+	buf.Reset()
+	require.NoError(t, (&RatingHistoryCSVFormatter{}).Format(&buf, testRatings()[0]))
+	records, err = csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, []string{"2026-03-04T14:00:00Z", "major", "RLCS 2026 Major 1", "p-sf1", "Semifinal 1", "FURIA Esports", "1500.0", "4", "2", "true", "1532.0", "1546.5"}, records[1])
+}
+
+func TestRatingsExportJSONFormatter_Format(t *testing.T) {
+	var buf bytes.Buffer
+	export := ratings.NewExport(testRatings(), ratings.DefaultOptions())
+	require.NoError(t, (&RatingsExportJSONFormatter{}).Format(&buf, export))
+
+	var decoded ratings.Export
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, ratings.SystemElo, decoded.Options.System)
+	assert.Len(t, decoded.Ratings, 2)
+}
+
+func TestGetRatingsFormatters(t *testing.T) {
+	for _, format := range []Format{FormatTable, FormatJSON, FormatCSV, FormatYAML} {
+		formatter, err := GetRatingsFormatter(format)
+		require.NoError(t, err)
+		assert.NotNil(t, formatter)
+
+		history, err := GetRatingHistoryFormatter(format)
+		require.NoError(t, err)
+		assert.NotNil(t, history)
+	}
+
+	for _, format := range []Format{FormatJSON, FormatCSV, FormatYAML} {
+		formatter, err := GetRatingsExportFormatter(format)
+		require.NoError(t, err)
+		assert.NotNil(t, formatter)
+	}
+
+	_, err := GetRatingsExportFormatter(FormatTable)
+	assert.Error(t, err)
+}
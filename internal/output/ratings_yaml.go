@@ -0,0 +1,45 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/ratings"
+	"gopkg.in/yaml.v3"
+)
+
+// RatingsYAMLFormatter outputs team ratings as YAML
+type RatingsYAMLFormatter struct{}
+
+func (f *RatingsYAMLFormatter) Format(w io.Writer, records []ratings.Rating) error {
+	return encodeRatingsYAML(w, records)
+}
+
+// RatingHistoryYAMLFormatter outputs the rating history of a team as YAML
+type RatingHistoryYAMLFormatter struct{}
+
+func (f *RatingHistoryYAMLFormatter) Format(w io.Writer, rating ratings.Rating) error {
+	return encodeRatingsYAML(w, rating)
+}
+
+// RatingsExportYAMLFormatter outputs a ratings export as YAML
+type RatingsExportYAMLFormatter struct{}
+
+func (f *RatingsExportYAMLFormatter) Format(w io.Writer, export ratings.Export) error {
+	return encodeRatingsYAML(w, export)
+}
+
+func encodeRatingsYAML(w io.Writer, v any) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(v); err != nil {
+		return fmt.Errorf("failed to encode ratings to YAML: %w", err)
+	}
+
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to close YAML encoder: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/calendar"
+)
+
+// ScheduleFormatter defines the interface for calendar output formatters
+type ScheduleFormatter interface {
+	// Format writes the events, now is the time the calendar is generated at
+	Format(w io.Writer, events []calendar.Event, now time.Time) error
+}
+
+// ScheduleFormat represents the output format for calendars
+type ScheduleFormat string
+
+const (
+	ScheduleFormatICS ScheduleFormat = "ics"
+)
+
+// scheduleRegistry holds all registered calendar formatters
+var scheduleRegistry = map[ScheduleFormat]ScheduleFormatter{
+	ScheduleFormatICS: &ScheduleICSFormatter{},
+}
+
+// GetScheduleFormatter returns the formatter for the given format
+func GetScheduleFormatter(format ScheduleFormat) (ScheduleFormatter, error) {
+	formatter, ok := scheduleRegistry[format]
+	if !ok {
+		return nil, fmt.Errorf("no formatter registered for format: %s", format)
+	}
+	return formatter, nil
+}
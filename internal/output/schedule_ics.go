@@ -0,0 +1,95 @@
+package output
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mgranderath/rlcs-cli/internal/calendar"
+)
+
+// icsLineLength is the maximum length of a content line in octets, longer lines are folded (RFC 5545 3.1)
+const icsLineLength = 75
+
+// ScheduleICSFormatter outputs events as an iCalendar (RFC 5545) file
+type ScheduleICSFormatter struct{}
+
+func (f *ScheduleICSFormatter) Format(w io.Writer, events []calendar.Event, now time.Time) error {
+	buf := bufio.NewWriter(w)
+	stamp := now.UTC().Format("20060102T150405Z")
+
+	writeICSLine(buf, "BEGIN:VCALENDAR")
+	writeICSLine(buf, "VERSION:2.0")
+	writeICSLine(buf, "PRODID:-//rlcs-cli//Schedule//EN")
+	writeICSLine(buf, "CALSCALE:GREGORIAN")
+	writeICSLine(buf, "METHOD:PUBLISH")
+	writeICSLine(buf, "X-WR-CALNAME:RLCS")
+
+	for _, event := range events {
+		writeICSLine(buf, "BEGIN:VEVENT")
+		writeICSLine(buf, "UID:"+escapeICSText(event.UID))
+		writeICSLine(buf, "DTSTAMP:"+stamp)
+		if event.AllDay {
+			writeICSLine(buf, "DTSTART;VALUE=DATE:"+event.Start.Format("20060102"))
+			writeICSLine(buf, "DTEND;VALUE=DATE:"+event.End.Format("20060102"))
+		} else {
+			writeICSLine(buf, "DTSTART:"+event.Start.UTC().Format("20060102T150405Z"))
+			writeICSLine(buf, "DTEND:"+event.End.UTC().Format("20060102T150405Z"))
+		}
+		writeICSLine(buf, fmt.Sprintf("SEQUENCE:%d", event.Sequence))
+		if !event.LastModified.IsZero() {
+			writeICSLine(buf, "LAST-MODIFIED:"+event.LastModified.UTC().Format("20060102T150405Z"))
+		}
+		writeICSLine(buf, "SUMMARY:"+escapeICSText(event.Summary))
+		if event.Description != "" {
+			writeICSLine(buf, "DESCRIPTION:"+escapeICSText(event.Description))
+		}
+		if event.Location != "" {
+			writeICSLine(buf, "LOCATION:"+escapeICSText(event.Location))
+		}
+		if event.AllDay {
+			writeICSLine(buf, "TRANSP:TRANSPARENT")
+		}
+		writeICSLine(buf, "END:VEVENT")
+	}
+
+	writeICSLine(buf, "END:VCALENDAR")
+
+	if err := buf.Flush(); err != nil {
+		return fmt.Errorf("failed to write calendar: %w", err)
+	}
+	return nil
+}
+
+// writeICSLine writes a content line terminated by CRLF, folding it into continuation lines
+// starting with a space without splitting multi-byte characters
+func writeICSLine(w *bufio.Writer, line string) {
+	limit := icsLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		w.WriteString(line[:cut])
+		w.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines start with a space that counts towards their length
+		limit = icsLineLength - 1
+	}
+	w.WriteString(line)
+	w.WriteString("\r\n")
+}
+
+// escapeICSText escapes a TEXT value (RFC 5545 3.3.11)
+func escapeICSText(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(value)
+}
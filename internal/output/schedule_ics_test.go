@@ -0,0 +1,83 @@
+package output
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mgranderath/rlcs-cli/internal/calendar"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleICSFormatter_Format(t *testing.T) {
+	start := time.Date(2026, 3, 5, 18, 0, 0, 0, time.UTC)
+	events := []calendar.Event{
+		{
+			UID:          "p-final@rlcs-cli",
+			Summary:      "Team Vitality vs TBD",
+			Description:  "RLCS 2026 Major 1\nGrand Final (BO7)",
+			Location:     "Paris, France",
+			Start:        start,
+			End:          start.Add(90 * time.Minute),
+			Sequence:     2,
+			LastModified: time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			UID:     "tournament-major@rlcs-cli",
+			Summary: "RLCS 2026 Major 1",
+			Start:   time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+			End:     time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC),
+			AllDay:  true,
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, (&ScheduleICSFormatter{}).Format(&buf, events, time.Date(2026, 3, 4, 18, 0, 0, 0, time.UTC)))
+
+	out := buf.String()
+	assert.True(t, strings.HasPrefix(out, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(out, "END:VCALENDAR\r\n"))
+	for _, line := range []string{
+		"UID:p-final@rlcs-cli",
+		"DTSTAMP:20260304T180000Z",
+		"DTSTART:20260305T180000Z",
+		"DTEND:20260305T193000Z",
+		"SEQUENCE:2",
+		"LAST-MODIFIED:20260304T120000Z",
+		"DESCRIPTION:RLCS 2026 Major 1\\nGrand Final (BO7)",
+		"LOCATION:Paris\\, France",
+		"DTSTART;VALUE=DATE:20260301",
+		"DTEND;VALUE=DATE:20260306",
+		"TRANSP:TRANSPARENT",
+	} {
+		assert.Contains(t, out, line+"\r\n")
+	}
+	assert.Equal(t, 2, strings.Count(out, "BEGIN:VEVENT"))
+}
+
+func TestWriteICSLine_Folding(t *testing.T) {
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+	writeICSLine(w, "SUMMARY:"+strings.Repeat("é", 80))
+	require.NoError(t, w.Flush())
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n")
+	require.Len(t, lines, 3)
+	for i, line := range lines {
+		assert.LessOrEqual(t, len(line), icsLineLength)
+		assert.True(t, utf8.ValidString(line))
+		if i > 0 {
+			assert.True(t, strings.HasPrefix(line, " "))
+		}
+	}
+	unfolded := strings.ReplaceAll(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n ", "")
+	assert.Equal(t, "SUMMARY:"+strings.Repeat("é", 80), unfolded)
+}
+
+func TestEscapeICSText(t *testing.T) {
+	assert.Equal(t, `a\\b\;c\,d\ne`, escapeICSText("a\\b;c,d\ne"))
+}
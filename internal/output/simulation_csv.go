@@ -0,0 +1,41 @@
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/simulate"
+)
+
+// SimulationCSVFormatter outputs a bracket simulation as CSV with one column per round
+type SimulationCSVFormatter struct{}
+
+func (f *SimulationCSVFormatter) Format(w io.Writer, result simulate.Result) error {
+	writer := csv.NewWriter(w)
+	defer writer.Flush()
+
+	// Write header
+	header := []string{"TeamUUID", "Team", "Shorthand"}
+	for _, round := range result.Rounds {
+		header = append(header, fmt.Sprintf("%s: %s", round.Bracket, round.Name))
+	}
+	header = append(header, "Win")
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	// Write rows
+	for _, team := range result.Teams {
+		record := []string{team.TeamUUID, team.Team, team.Shorthand}
+		for _, probability := range team.Reach {
+			record = append(record, fmt.Sprintf("%.4f", probability))
+		}
+		record = append(record, fmt.Sprintf("%.4f", team.Win))
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write CSV record: %w", err)
+		}
+	}
+
+	return nil
+}
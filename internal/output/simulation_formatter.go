@@ -0,0 +1,30 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/simulate"
+)
+
+// SimulationFormatter defines the interface for bracket simulation output formatters
+type SimulationFormatter interface {
+	Format(w io.Writer, result simulate.Result) error
+}
+
+// simulationRegistry holds all registered simulation formatters
+var simulationRegistry = map[Format]SimulationFormatter{
+	FormatTable: &SimulationTableFormatter{},
+	FormatJSON:  &SimulationJSONFormatter{},
+	FormatCSV:   &SimulationCSVFormatter{},
+	FormatYAML:  &SimulationYAMLFormatter{},
+}
+
+// GetSimulationFormatter returns the formatter for the given format
+func GetSimulationFormatter(format Format) (SimulationFormatter, error) {
+	formatter, ok := simulationRegistry[format]
+	if !ok {
+		return nil, fmt.Errorf("no formatter registered for format: %s", format)
+	}
+	return formatter, nil
+}
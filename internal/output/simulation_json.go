@@ -0,0 +1,17 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/simulate"
+)
+
+// SimulationJSONFormatter outputs a bracket simulation as formatted JSON
+type SimulationJSONFormatter struct{}
+
+func (f *SimulationJSONFormatter) Format(w io.Writer, result simulate.Result) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
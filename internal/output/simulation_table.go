@@ -0,0 +1,75 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mgranderath/rlcs-cli/internal/simulate"
+)
+
+// SimulationTableFormatter outputs a bracket simulation as an ASCII table with one column per round
+type SimulationTableFormatter struct{}
+
+func (f *SimulationTableFormatter) Format(w io.Writer, result simulate.Result) error {
+	if len(result.Teams) == 0 {
+		fmt.Fprintln(w, "No teams found")
+		return nil
+	}
+
+	fmt.Fprintf(w, "\nSimulated %d runs (seed %d)\n\n", result.Runs, result.Seed)
+
+	// Every round gets a column, the event winner gets the last one
+	headers := make([]string, 0, len(result.Rounds)+1)
+	for _, round := range result.Rounds {
+		headers = append(headers, round.Name)
+	}
+	headers = append(headers, "Win")
+
+	border := func(left, middle, right string) string {
+		var b strings.Builder
+		b.WriteString(left + strings.Repeat("─", 26))
+		for range headers {
+			b.WriteString(middle + strings.Repeat("─", 14))
+		}
+		b.WriteString(right)
+		return b.String()
+	}
+
+	// Write header
+	fmt.Fprintln(w, border("┌", "┬", "┐"))
+	fmt.Fprintf(w, "│ %-24s ", "Team")
+	for _, header := range headers {
+		fmt.Fprintf(w, "│ %-12s ", truncate(header, 12))
+	}
+	fmt.Fprintln(w, "│")
+	fmt.Fprintln(w, border("├", "┼", "┤"))
+
+	// Write teams
+	for _, team := range result.Teams {
+		fmt.Fprintf(w, "│ %-24s ", truncate(team.Team, 24))
+		for _, probability := range team.Reach {
+			fmt.Fprintf(w, "│ %12s ", formatProbability(probability))
+		}
+		fmt.Fprintf(w, "│ %12s │\n", formatProbability(team.Win))
+	}
+
+	fmt.Fprintln(w, border("└", "┴", "┘"))
+
+	return nil
+}
+
+// formatProbability formats a probability as a percentage
+func formatProbability(probability float64) string {
+	switch {
+	case probability == 0:
+		return "-"
+	case probability == 1:
+		return "100%"
+	case probability < 0.001:
+		return "<0.1%"
+	case probability > 0.999:
+		return ">99.9%"
+	}
+	return fmt.Sprintf("%.1f%%", probability*100)
+}
@@ -0,0 +1,77 @@
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/mgranderath/rlcs-cli/internal/simulate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSimulation() simulate.Result {
+	return simulate.Result{
+		Runs: 10000,
+		Seed: 42,
+		Rounds: []simulate.Round{
+			{Bracket: "Playoffs", Name: "Semifinal"},
+			{Bracket: "Playoffs", Name: "Grand Final"},
+		},
+		Teams: []simulate.TeamOdds{
+			{TeamUUID: "vit", Team: "Team Vitality", Shorthand: "VIT", Reach: []float64{1, 1}, Win: 0.5573},
+			{TeamUUID: "ssg", Team: "Spacestation Gaming", Shorthand: "SSG", Reach: []float64{1, 0.0004}, Win: 0.00001},
+			{TeamUUID: "fur", Team: "FURIA Esports", Shorthand: "FUR", Reach: []float64{1, 0}},
+		},
+	}
+}
+
+func TestSimulationTableFormatter_Format(t *testing.T) {
+	formatter := &SimulationTableFormatter{}
+
+	t.Run("no teams", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, formatter.Format(&buf, simulate.Result{}))
+		assert.Equal(t, "No teams found\n", buf.String())
+	})
+
+	t.Run("simulation", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, formatter.Format(&buf, testSimulation()))
+
+		out := buf.String()
+		for _, s := range []string{"Simulated 10000 runs (seed 42)", "Semifinal", "Grand Final", "Win", "Team Vitality", "100%", "55.7%", "<0.1%"} {
+			assert.Contains(t, out, s)
+		}
+	})
+}
+
+func TestFormatProbability(t *testing.T) {
+	assert.Equal(t, "-", formatProbability(0))
+	assert.Equal(t, "100%", formatProbability(1))
+	assert.Equal(t, "<0.1%", formatProbability(0.0004))
+	assert.Equal(t, ">99.9%", formatProbability(0.9996))
+	assert.Equal(t, "12.3%", formatProbability(0.123))
+}
+
+func TestSimulationCSVFormatter_Format(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, (&SimulationCSVFormatter{}).Format(&buf, testSimulation()))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+	assert.Equal(t, []string{"TeamUUID", "Team", "Shorthand", "Playoffs: Semifinal", "Playoffs: Grand Final", "Win"}, records[0])
+	assert.Equal(t, []string{"vit", "Team Vitality", "VIT", "1.0000", "1.0000", "0.5573"}, records[1])
+}
+
+func TestGetSimulationFormatter(t *testing.T) {
+	for _, format := range []Format{FormatTable, FormatJSON, FormatCSV, FormatYAML} {
+		formatter, err := GetSimulationFormatter(format)
+		require.NoError(t, err)
+		assert.NotNil(t, formatter)
+	}
+
+	_, err := GetSimulationFormatter("xml")
+	assert.Error(t, err)
+}
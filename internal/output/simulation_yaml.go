@@ -0,0 +1,27 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/simulate"
+	"gopkg.in/yaml.v3"
+)
+
+// SimulationYAMLFormatter outputs a bracket simulation as YAML
+type SimulationYAMLFormatter struct{}
+
+func (f *SimulationYAMLFormatter) Format(w io.Writer, result simulate.Result) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(result); err != nil {
+		return fmt.Errorf("failed to encode simulation to YAML: %w", err)
+	}
+
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to close YAML encoder: %w", err)
+	}
+
+	return nil
+}
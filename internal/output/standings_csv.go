@@ -0,0 +1,54 @@
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/standings"
+)
+
+// StandingsCSVFormatter outputs bracket standings as CSV with one row per team and bracket
+type StandingsCSVFormatter struct{}
+
+func (f *StandingsCSVFormatter) Format(w io.Writer, tables []standings.Table) error {
+	writer := csv.NewWriter(w)
+	defer writer.Flush()
+
+	// Write header
+	header := []string{"BracketUUID", "Bracket", "Label", "Format", "Rank", "TeamUUID", "Team", "Shorthand", "SeriesWins", "SeriesLosses", "GameWins", "GameLosses", "GameDifferential", "HeadToHeadWins", "Buchholz", "Remaining", "IsEliminated", "Tiebreaker"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	// Write rows
+	for _, table := range tables {
+		for _, s := range table.Standings {
+			record := []string{
+				table.BracketUUID,
+				table.Bracket,
+				table.Label,
+				table.Format,
+				fmt.Sprintf("%d", s.Rank),
+				s.TeamUUID,
+				s.Team,
+				s.Shorthand,
+				fmt.Sprintf("%d", s.SeriesWins),
+				fmt.Sprintf("%d", s.SeriesLosses),
+				fmt.Sprintf("%d", s.GameWins),
+				fmt.Sprintf("%d", s.GameLosses),
+				fmt.Sprintf("%d", s.GameDifferential),
+				fmt.Sprintf("%d", s.HeadToHeadWins),
+				fmt.Sprintf("%d", s.Buchholz),
+				fmt.Sprintf("%d", s.Remaining),
+				fmt.Sprintf("%t", s.IsEliminated),
+				string(s.Tiebreaker),
+			}
+			if err := writer.Write(record); err != nil {
+				return fmt.Errorf("failed to write CSV record: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/standings"
+)
+
+// StandingsFormatter defines the interface for bracket standings output formatters
+type StandingsFormatter interface {
+	Format(w io.Writer, tables []standings.Table) error
+}
+
+// standingsRegistry holds all registered standings formatters
+var standingsRegistry = map[Format]StandingsFormatter{
+	FormatTable: &StandingsTableFormatter{},
+	FormatJSON:  &StandingsJSONFormatter{},
+	FormatCSV:   &StandingsCSVFormatter{},
+	FormatYAML:  &StandingsYAMLFormatter{},
+}
+
+// GetStandingsFormatter returns the formatter for the given format
+func GetStandingsFormatter(format Format) (StandingsFormatter, error) {
+	formatter, ok := standingsRegistry[format]
+	if !ok {
+		return nil, fmt.Errorf("no formatter registered for format: %s", format)
+	}
+	return formatter, nil
+}
@@ -0,0 +1,17 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/standings"
+)
+
+// StandingsJSONFormatter outputs bracket standings as formatted JSON
+type StandingsJSONFormatter struct{}
+
+func (f *StandingsJSONFormatter) Format(w io.Writer, tables []standings.Table) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(tables)
+}
@@ -0,0 +1,59 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mgranderath/rlcs-cli/internal/standings"
+)
+
+// StandingsTableFormatter outputs the standings of every bracket as an ASCII table
+type StandingsTableFormatter struct{}
+
+func (f *StandingsTableFormatter) Format(w io.Writer, tables []standings.Table) error {
+	if len(tables) == 0 {
+		fmt.Fprintln(w, "No Swiss or round-robin brackets found")
+		return nil
+	}
+
+	for i, table := range tables {
+		// Add separator between brackets (except before the first one)
+		if i > 0 {
+			fmt.Fprintln(w, "\n"+strings.Repeat("=", 80))
+		}
+
+		// Write bracket header
+		fmt.Fprintf(w, "\n%s (%s)\n", table.Bracket, table.Label)
+		fmt.Fprintf(w, "Format: %s\n\n", table.Format)
+
+		// Write standings table header
+		fmt.Fprintln(w, "┌─────┬──────────────────────────┬─────────┬─────────┬───────┬─────┬──────┬──────┬───────────────────┬────────────┐")
+		fmt.Fprintln(w, "│ #   │ Team                     │ Series  │ Games   │ Diff  │ H2H │ Buch │ Left │ Tiebreaker        │ Status     │")
+		fmt.Fprintln(w, "├─────┼──────────────────────────┼─────────┼─────────┼───────┼─────┼──────┼──────┼───────────────────┼────────────┤")
+
+		// Write standings
+		for _, s := range table.Standings {
+			rank := fmt.Sprintf("%d", s.Rank)
+			team := truncate(s.Team, 24)
+			series := fmt.Sprintf("%d-%d", s.SeriesWins, s.SeriesLosses)
+			games := fmt.Sprintf("%d-%d", s.GameWins, s.GameLosses)
+			diff := fmt.Sprintf("%+d", s.GameDifferential)
+			h2h := fmt.Sprintf("%d", s.HeadToHeadWins)
+			buchholz := fmt.Sprintf("%d", s.Buchholz)
+			remaining := fmt.Sprintf("%d", s.Remaining)
+			tiebreaker := string(s.Tiebreaker)
+			status := ""
+			if s.IsEliminated {
+				status = "Eliminated"
+			}
+
+			fmt.Fprintf(w, "│ %-3s │ %-24s │ %-7s │ %-7s │ %-5s │ %-3s │ %-4s │ %-4s │ %-17s │ %-10s │\n",
+				rank, team, series, games, diff, h2h, buchholz, remaining, tiebreaker, status)
+		}
+
+		fmt.Fprintln(w, "└─────┴──────────────────────────┴─────────┴─────────┴───────┴─────┴──────┴──────┴───────────────────┴────────────┘")
+	}
+
+	return nil
+}
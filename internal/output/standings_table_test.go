@@ -0,0 +1,70 @@
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/mgranderath/rlcs-cli/internal/standings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testStandings() []standings.Table {
+	return []standings.Table{
+		{
+			BracketUUID: "swiss-1",
+			Bracket:     "Swiss Stage",
+			Label:       "Swiss",
+			Format:      "swiss-16",
+			Standings: []standings.Standing{
+				{Rank: 1, TeamUUID: "vit", Team: "Team Vitality", SeriesWins: 2, GameWins: 6, GameLosses: 1, GameDifferential: 5, Buchholz: 1, Tiebreaker: standings.TiebreakGameDifferential},
+				{Rank: 2, TeamUUID: "kc", Team: "Karmine Corp", SeriesWins: 2, GameWins: 6, GameLosses: 4, GameDifferential: 2, Buchholz: 1, Tiebreaker: standings.TiebreakGameDifferential},
+				{Rank: 3, TeamUUID: "g2", Team: "G2 Esports", SeriesLosses: 2, GameWins: 1, GameLosses: 6, GameDifferential: -5, IsEliminated: true},
+			},
+		},
+	}
+}
+
+func TestStandingsTableFormatter_Format(t *testing.T) {
+	formatter := &StandingsTableFormatter{}
+
+	t.Run("no brackets", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, formatter.Format(&buf, nil))
+		assert.Equal(t, "No Swiss or round-robin brackets found\n", buf.String())
+	})
+
+	t.Run("standings", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, formatter.Format(&buf, testStandings()))
+
+		out := buf.String()
+		for _, s := range []string{"Swiss Stage (Swiss)", "Format: swiss-16", "Team Vitality", "2-0", "6-1", "+5", "-5", "game-differential", "Eliminated"} {
+			assert.Contains(t, out, s)
+		}
+	})
+}
+
+func TestStandingsCSVFormatter_Format(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, (&StandingsCSVFormatter{}).Format(&buf, testStandings()))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+	assert.Equal(t, "BracketUUID", records[0][0])
+	assert.Equal(t, []string{"swiss-1", "Swiss Stage", "Swiss", "swiss-16", "1", "vit", "Team Vitality", "", "2", "0", "6", "1", "5", "0", "1", "0", "false", "game-differential"}, records[1])
+	assert.Equal(t, "true", records[3][16])
+}
+
+func TestGetStandingsFormatter(t *testing.T) {
+	for _, format := range []Format{FormatTable, FormatJSON, FormatCSV, FormatYAML} {
+		formatter, err := GetStandingsFormatter(format)
+		require.NoError(t, err)
+		assert.NotNil(t, formatter)
+	}
+
+	_, err := GetStandingsFormatter("xml")
+	assert.Error(t, err)
+}
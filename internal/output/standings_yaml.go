@@ -0,0 +1,27 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/standings"
+	"gopkg.in/yaml.v3"
+)
+
+// StandingsYAMLFormatter outputs bracket standings as YAML
+type StandingsYAMLFormatter struct{}
+
+func (f *StandingsYAMLFormatter) Format(w io.Writer, tables []standings.Table) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(tables); err != nil {
+		return fmt.Errorf("failed to encode standings to YAML: %w", err)
+	}
+
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to close YAML encoder: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/stats"
+)
+
+// StatsCSVFormatter outputs the statistics of every team as CSV, durations are in seconds
+type StatsCSVFormatter struct{}
+
+func (f *StatsCSVFormatter) Format(w io.Writer, s stats.Stats) error {
+	writer := csv.NewWriter(w)
+	defer writer.Flush()
+
+	// Write header
+	header := []string{
+		"TeamUUID", "Team", "Shorthand", "Series", "SeriesWins", "Games", "GameWins",
+		"GoalsFor", "GoalsAgainst", "GoalDifferential", "Sweeps", "Swept", "Deciders", "DecidersWon",
+		"ReverseSweeps", "Comebacks", "OvertimeGames", "OvertimeWins", "AverageDuration",
+	}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	// Write rows
+	for _, team := range s.Teams {
+		record := []string{
+			team.UUID,
+			team.Name,
+			team.Shorthand,
+			fmt.Sprintf("%d", team.Series),
+			fmt.Sprintf("%d", team.SeriesWins),
+			fmt.Sprintf("%d", team.Games),
+			fmt.Sprintf("%d", team.GameWins),
+			fmt.Sprintf("%d", team.GoalsFor),
+			fmt.Sprintf("%d", team.GoalsAgainst),
+			fmt.Sprintf("%d", team.GoalDifferential),
+			fmt.Sprintf("%d", team.Sweeps),
+			fmt.Sprintf("%d", team.Swept),
+			fmt.Sprintf("%d", team.Deciders),
+			fmt.Sprintf("%d", team.DecidersWon),
+			fmt.Sprintf("%d", team.ReverseSweeps),
+			fmt.Sprintf("%d", team.Comebacks),
+			fmt.Sprintf("%d", team.OvertimeGames),
+			fmt.Sprintf("%d", team.OvertimeWins),
+			fmt.Sprintf("%.0f", team.AverageDuration.Seconds()),
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write CSV record: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/stats"
+)
+
+// StatsFormatter defines the interface for statistics output formatters
+type StatsFormatter interface {
+	Format(w io.Writer, s stats.Stats) error
+}
+
+// statsRegistry holds all registered statistics formatters
+var statsRegistry = map[Format]StatsFormatter{
+	FormatTable: &StatsTableFormatter{},
+	FormatJSON:  &StatsJSONFormatter{},
+	FormatCSV:   &StatsCSVFormatter{},
+	FormatYAML:  &StatsYAMLFormatter{},
+}
+
+// GetStatsFormatter returns the formatter for the given format
+func GetStatsFormatter(format Format) (StatsFormatter, error) {
+	formatter, ok := statsRegistry[format]
+	if !ok {
+		return nil, fmt.Errorf("no formatter registered for format: %s", format)
+	}
+	return formatter, nil
+}
@@ -0,0 +1,17 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/stats"
+)
+
+// StatsJSONFormatter outputs statistics as formatted JSON, durations are in nanoseconds
+type StatsJSONFormatter struct{}
+
+func (f *StatsJSONFormatter) Format(w io.Writer, s stats.Stats) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(s)
+}
@@ -0,0 +1,115 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/stats"
+)
+
+// StatsTableFormatter outputs statistics as a summary followed by the longest games,
+// the comebacks and the statistics of every team
+type StatsTableFormatter struct{}
+
+func (f *StatsTableFormatter) Format(w io.Writer, s stats.Stats) error {
+	if s.Series == 0 {
+		fmt.Fprintln(w, "No completed series found")
+		return nil
+	}
+
+	// Write summary
+	fmt.Fprintf(w, "\nTournaments: %d, Series: %d, Games: %d, Goals: %d\n", s.Tournaments, s.Series, s.Games, s.Goals)
+	if s.TimedGames > 0 {
+		fmt.Fprintf(w, "Average game: %s (%d timed games)\n", formatDuration(s.AverageDuration), s.TimedGames)
+	}
+	fmt.Fprintf(w, "Likely overtime games: %d\n", s.OvertimeGames)
+	fmt.Fprintf(w, "Sweeps: %d, Reverse sweeps: %d, Deciders: %d, Comebacks: %d\n", s.Sweeps, s.ReverseSweeps, s.Deciders, s.Comebacks)
+
+	// Write longest games
+	if len(s.Longest) > 0 {
+		fmt.Fprintln(w, "\nLongest games")
+		fmt.Fprintln(w, "┌──────────┬───────────────────────────────────────┬─────────┬───────────────────────────────────────────────┐")
+		fmt.Fprintln(w, "│ Duration │ Teams                                 │ Score   │ Series                                        │")
+		fmt.Fprintln(w, "├──────────┼───────────────────────────────────────┼─────────┼───────────────────────────────────────────────┤")
+		for _, game := range s.Longest {
+			duration := formatDuration(game.Duration)
+			if game.Overtime {
+				duration += " OT"
+			}
+			teams := fmt.Sprintf("%s vs %s", truncate(game.TeamA, 17), truncate(game.TeamB, 17))
+			score := fmt.Sprintf("%d - %d", game.TeamAScore, game.TeamBScore)
+			series := truncate(joinNonEmpty(" / ", game.TournamentName, game.Stage, game.Name), 45)
+
+			fmt.Fprintf(w, "│ %-8s │ %-37s │ %-7s │ %-45s │\n", duration, teams, score, series)
+		}
+		fmt.Fprintln(w, "└──────────┴───────────────────────────────────────┴─────────┴───────────────────────────────────────────────┘")
+	}
+
+	// Write comebacks
+	if len(s.ComebackSeries) > 0 {
+		fmt.Fprintln(w, "\nComebacks")
+		fmt.Fprintln(w, "┌───────────────────────────────────────┬─────────┬─────────┬───────────────────────────────────────────────┐")
+		fmt.Fprintln(w, "│ Winner vs Loser                       │ Score   │ Down    │ Series                                        │")
+		fmt.Fprintln(w, "├───────────────────────────────────────┼─────────┼─────────┼───────────────────────────────────────────────┤")
+		for _, c := range s.ComebackSeries {
+			teams := fmt.Sprintf("%s vs %s", truncate(c.Winner, 17), truncate(c.Loser, 17))
+			score := fmt.Sprintf("%d - %d", c.WinnerScore, c.LoserScore)
+			down := fmt.Sprintf("0 - %d", c.Deficit)
+			if !c.ReverseSweep {
+				down = fmt.Sprintf("-%d", c.Deficit)
+			}
+			series := truncate(joinNonEmpty(" / ", c.TournamentName, c.Stage), 45)
+
+			fmt.Fprintf(w, "│ %-37s │ %-7s │ %-7s │ %-45s │\n", teams, score, down, series)
+		}
+		fmt.Fprintln(w, "└───────────────────────────────────────┴─────────┴─────────┴───────────────────────────────────────────────┘")
+	}
+
+	// Write teams
+	fmt.Fprintln(w, "\nTeams")
+	fmt.Fprintln(w, "┌──────────────────────────┬───────┬─────────┬─────────┬───────┬───────┬───────┬────────┬──────────┬───────────┬───────┬──────────┐")
+	fmt.Fprintln(w, "│ Team                     │ Tag   │ Series  │ Games   │ GF    │ GA    │ Diff  │ Sweeps │ Deciders │ Comebacks │ OT    │ Avg Game │")
+	fmt.Fprintln(w, "├──────────────────────────┼───────┼─────────┼─────────┼───────┼───────┼───────┼────────┼──────────┼───────────┼───────┼──────────┤")
+	for _, team := range s.Teams {
+		name := truncate(team.Name, 24)
+		tag := truncate(team.Shorthand, 5)
+		series := fmt.Sprintf("%d-%d", team.SeriesWins, team.Series-team.SeriesWins)
+		games := fmt.Sprintf("%d-%d", team.GameWins, team.Games-team.GameWins)
+		diff := fmt.Sprintf("%+d", team.GoalDifferential)
+		sweeps := fmt.Sprintf("%d-%d", team.Sweeps, team.Swept)
+		deciders := fmt.Sprintf("%d-%d", team.DecidersWon, team.Deciders-team.DecidersWon)
+		overtime := fmt.Sprintf("%d-%d", team.OvertimeWins, team.OvertimeGames-team.OvertimeWins)
+		average := "-"
+		if team.AverageDuration > 0 {
+			average = formatDuration(team.AverageDuration)
+		}
+
+		fmt.Fprintf(w, "│ %-24s │ %-5s │ %-7s │ %-7s │ %5d │ %5d │ %5s │ %-6s │ %-8s │ %9d │ %-5s │ %8s │\n",
+			name, tag, series, games, team.GoalsFor, team.GoalsAgainst, diff, sweeps, deciders, team.Comebacks, overtime, average)
+	}
+	fmt.Fprintln(w, "└──────────────────────────┴───────┴─────────┴─────────┴───────┴───────┴───────┴────────┴──────────┴───────────┴───────┴──────────┘")
+
+	return nil
+}
+
+// formatDuration formats a duration as minutes and seconds, e.g. "6:42"
+func formatDuration(d time.Duration) string {
+	seconds := int(d.Round(time.Second).Seconds())
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
+
+// joinNonEmpty joins the values that are not empty with sep
+func joinNonEmpty(sep string, values ...string) string {
+	result := ""
+	for _, value := range values {
+		if value == "" {
+			continue
+		}
+		if result != "" {
+			result += sep
+		}
+		result += value
+	}
+	return result
+}
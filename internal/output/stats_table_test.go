@@ -0,0 +1,77 @@
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/stats"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testStats() stats.Stats {
+	return stats.Stats{
+		Tournaments:     1,
+		Series:          3,
+		Games:           11,
+		Goals:           52,
+		TimedGames:      11,
+		AverageDuration: 6*time.Minute + 54*time.Second,
+		Longest: []stats.Game{
+			{TournamentName: "Major", Stage: "Semifinal 1", Name: "Game 2", TeamA: "Team Vitality", TeamB: "FURIA Esports", TeamAScore: 3, TeamBScore: 2, Duration: 11*time.Minute + 3*time.Second, Overtime: true},
+		},
+		OvertimeGames: 1,
+		ReverseSweeps: 1,
+		Comebacks:     1,
+		ComebackSeries: []stats.Comeback{
+			{TournamentName: "Major", Stage: "Semifinal 1", Winner: "FURIA Esports", Loser: "Team Vitality", WinnerScore: 4, LoserScore: 3, Deficit: 3, ReverseSweep: true},
+		},
+		Teams: []stats.Team{
+			{UUID: "fur", Name: "FURIA Esports", Shorthand: "FUR", Series: 1, SeriesWins: 1, Games: 7, GameWins: 4, GoalsFor: 20, GoalsAgainst: 18, GoalDifferential: 2, ReverseSweeps: 1, Comebacks: 1, Deciders: 1, DecidersWon: 1, AverageDuration: 7 * time.Minute},
+		},
+	}
+}
+
+func TestStatsTableFormatter_Format(t *testing.T) {
+	formatter := &StatsTableFormatter{}
+
+	t.Run("no series", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, formatter.Format(&buf, stats.Stats{}))
+		assert.Equal(t, "No completed series found\n", buf.String())
+	})
+
+	t.Run("stats", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, formatter.Format(&buf, testStats()))
+
+		out := buf.String()
+		for _, s := range []string{
+			"Tournaments: 1, Series: 3, Games: 11, Goals: 52",
+			"Average game: 6:54 (11 timed games)",
+			"Sweeps: 0, Reverse sweeps: 1, Deciders: 0, Comebacks: 1",
+			"11:03 OT", "Major / Semifinal 1 / Game 2", "0 - 3", "FURIA Esports", "+2", "7:00",
+		} {
+			assert.Contains(t, out, s)
+		}
+	})
+}
+
+func TestStatsCSVFormatter_Format(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, (&StatsCSVFormatter{}).Format(&buf, testStats()))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, "TeamUUID", records[0][0])
+	assert.Equal(t, []string{"fur", "FURIA Esports", "FUR", "1", "1", "7", "4", "20", "18", "2", "0", "0", "1", "1", "1", "1", "0", "0", "420"}, records[1])
+}
+
+func TestFormatDuration(t *testing.T) {
+	assert.Equal(t, "6:42", formatDuration(6*time.Minute+42*time.Second))
+	assert.Equal(t, "10:00", formatDuration(9*time.Minute+59700*time.Millisecond))
+	assert.Equal(t, "0:00", formatDuration(0))
+}
@@ -0,0 +1,27 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/stats"
+	"gopkg.in/yaml.v3"
+)
+
+// StatsYAMLFormatter outputs statistics as YAML
+type StatsYAMLFormatter struct{}
+
+func (f *StatsYAMLFormatter) Format(w io.Writer, s stats.Stats) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(s); err != nil {
+		return fmt.Errorf("failed to encode statistics to YAML: %w", err)
+	}
+
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to close YAML encoder: %w", err)
+	}
+
+	return nil
+}
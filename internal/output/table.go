@@ -3,7 +3,7 @@ package output
 import (
 	"fmt"
 	"io"
-	"strings"
+	"time"
 	"unicode/utf8"
 
 	"github.com/mgranderath/rlcs-cli/internal/domain"
@@ -46,38 +46,22 @@ func truncate(s string, maxLen int) string {
 	return string(runes[:maxLen-3]) + "..."
 }
 
-func formatDateRange(start, end interface{}) string {
-	// Handle both string and time.Time
-	startStr := fmt.Sprintf("%v", start)
-	endStr := fmt.Sprintf("%v", end)
-
-	// Parse if they contain T (time format)
-	if strings.Contains(startStr, "T") {
-		startStr = startStr[:10]
-	}
-	if strings.Contains(endStr, "T") {
-		endStr = endStr[:10]
+// formatDateRange returns a compact range of dates in their time zone, e.g. "Jan 15-17 '26"
+func formatDateRange(start, end time.Time) string {
+	switch {
+	case start.IsZero() && end.IsZero():
+		return "-"
+	case end.IsZero():
+		return start.Format("Jan 02 '06")
+	case start.IsZero():
+		return end.Format("Jan 02 '06")
 	}
 
-	// If same month, show abbreviated format
-	if len(startStr) >= 10 && len(endStr) >= 10 {
-		startMonth := startStr[5:7]
-		endMonth := endStr[5:7]
-		startDay := startStr[8:10]
-		endDay := endStr[8:10]
-		year := startStr[2:4]
-
-		monthNames := map[string]string{
-			"01": "Jan", "02": "Feb", "03": "Mar", "04": "Apr",
-			"05": "May", "06": "Jun", "07": "Jul", "08": "Aug",
-			"09": "Sep", "10": "Oct", "11": "Nov", "12": "Dec",
-		}
-
-		if startMonth == endMonth {
-			return fmt.Sprintf("%s %s-%s '%s", monthNames[startMonth], startDay, endDay, year)
-		}
-		return fmt.Sprintf("%s %s-%s %s '%s", monthNames[startMonth], startDay, monthNames[endMonth], endDay, year)
+	if start.Year() != end.Year() {
+		return start.Format("Jan 02 '06") + "-" + end.Format("Jan 02 '06")
 	}
-
-	return fmt.Sprintf("%s - %s", startStr, endStr)
+	if start.Month() == end.Month() {
+		return fmt.Sprintf("%s-%s", start.Format("Jan 02"), end.Format("02 '06"))
+	}
+	return fmt.Sprintf("%s-%s", start.Format("Jan 02"), end.Format("Jan 02 '06"))
 }
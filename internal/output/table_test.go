@@ -136,41 +136,46 @@ func TestTruncate(t *testing.T) {
 }
 
 func TestFormatDateRange(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+
 	tests := []struct {
 		name     string
-		start    interface{}
-		end      interface{}
+		start    time.Time
+		end      time.Time
 		expected string
 	}{
 		{
-			name:     "same month time.Time",
+			name:     "same month",
 			start:    time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC),
 			end:      time.Date(2026, 1, 17, 0, 0, 0, 0, time.UTC),
 			expected: "Jan 15-17 '26",
 		},
 		{
-			name:     "different months time.Time",
+			name:     "different months",
 			start:    time.Date(2026, 1, 30, 0, 0, 0, 0, time.UTC),
 			end:      time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
 			expected: "Jan 30-Feb 01 '26",
 		},
 		{
-			name:     "string dates with T",
-			start:    "2026-01-15T00:00:00Z",
-			end:      "2026-01-17T00:00:00Z",
-			expected: "Jan 15-17 '26",
+			name:     "different years",
+			start:    time.Date(2025, 12, 30, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+			expected: "Dec 30 '25-Jan 02 '26",
 		},
 		{
-			name:     "string dates without T",
-			start:    "2026-01-15",
-			end:      "2026-01-17",
-			expected: "Jan 15-17 '26",
+			name:     "dates in their time zone",
+			start:    time.Date(2026, 1, 31, 16, 0, 0, 0, time.UTC).In(tokyo),
+			end:      time.Date(2026, 2, 2, 16, 0, 0, 0, time.UTC).In(tokyo),
+			expected: "Feb 01-03 '26",
+		},
+		{
+			name:     "missing end",
+			start:    time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC),
+			expected: "Jan 15 '26",
 		},
 		{
-			name:     "invalid format fallback",
-			start:    "invalid",
-			end:      "also invalid",
-			expected: "invalid - also invalid",
+			name:     "no dates",
+			expected: "-",
 		},
 	}
 
@@ -0,0 +1,59 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/teams"
+)
+
+// TeamsFormatter defines the interface for team list output formatters
+type TeamsFormatter interface {
+	Format(w io.Writer, records []teams.Team) error
+}
+
+// TeamFormatter defines the interface for single team output formatters
+type TeamFormatter interface {
+	Format(w io.Writer, team teams.Team) error
+}
+
+// TeamsFormat represents the output format for teams
+type TeamsFormat string
+
+const (
+	TeamsFormatTable TeamsFormat = "table"
+	TeamsFormatJSON  TeamsFormat = "json"
+	TeamsFormatYAML  TeamsFormat = "yaml"
+)
+
+// teamsRegistry holds all registered team list formatters
+var teamsRegistry = map[TeamsFormat]TeamsFormatter{
+	TeamsFormatTable: &TeamsTableFormatter{},
+	TeamsFormatJSON:  &TeamsJSONFormatter{},
+	TeamsFormatYAML:  &TeamsYAMLFormatter{},
+}
+
+// teamRegistry holds all registered single team formatters
+var teamRegistry = map[TeamsFormat]TeamFormatter{
+	TeamsFormatTable: &TeamTableFormatter{},
+	TeamsFormatJSON:  &TeamJSONFormatter{},
+	TeamsFormatYAML:  &TeamYAMLFormatter{},
+}
+
+// GetTeamsFormatter returns the team list formatter for the given format
+func GetTeamsFormatter(format TeamsFormat) (TeamsFormatter, error) {
+	formatter, ok := teamsRegistry[format]
+	if !ok {
+		return nil, fmt.Errorf("no formatter registered for format: %s", format)
+	}
+	return formatter, nil
+}
+
+// GetTeamFormatter returns the single team formatter for the given format
+func GetTeamFormatter(format TeamsFormat) (TeamFormatter, error) {
+	formatter, ok := teamRegistry[format]
+	if !ok {
+		return nil, fmt.Errorf("no formatter registered for format: %s", format)
+	}
+	return formatter, nil
+}
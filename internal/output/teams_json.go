@@ -0,0 +1,26 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/teams"
+)
+
+// TeamsJSONFormatter outputs teams as formatted JSON
+type TeamsJSONFormatter struct{}
+
+func (f *TeamsJSONFormatter) Format(w io.Writer, records []teams.Team) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(records)
+}
+
+// TeamJSONFormatter outputs a single team as formatted JSON
+type TeamJSONFormatter struct{}
+
+func (f *TeamJSONFormatter) Format(w io.Writer, team teams.Team) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(team)
+}
@@ -0,0 +1,126 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mgranderath/rlcs-cli/internal/teams"
+)
+
+// TeamsTableFormatter outputs teams as an ASCII table
+type TeamsTableFormatter struct{}
+
+func (f *TeamsTableFormatter) Format(w io.Writer, records []teams.Team) error {
+	if len(records) == 0 {
+		fmt.Fprintln(w, "No teams found")
+		return nil
+	}
+
+	// Write header
+	fmt.Fprintln(w, "┌─────┬──────────────────────────┬───────┬──────────┬────────┬─────────┬─────────┬───────┬────────┐")
+	fmt.Fprintln(w, "│ #   │ Team                     │ Tag   │ Region   │ Events │ Series  │ Games   │ Diff  │ Recent │")
+	fmt.Fprintln(w, "├─────┼──────────────────────────┼───────┼──────────┼────────┼─────────┼─────────┼───────┼────────┤")
+
+	// Write teams
+	for i, team := range records {
+		rank := fmt.Sprintf("%d", i+1)
+		name := truncate(team.Name, 24)
+		tag := truncate(team.Shorthand, 5)
+		region := truncate(formatRegions(team), 8)
+		events := fmt.Sprintf("%d", len(team.Tournaments))
+		series := fmt.Sprintf("%d-%d", team.SeriesWins, team.SeriesLosses)
+		games := fmt.Sprintf("%d-%d", team.GameWins, team.GameLosses)
+		diff := fmt.Sprintf("%+d", team.GameDifferential)
+		recent := formatForm(team.Recent)
+
+		fmt.Fprintf(w, "│ %-3s │ %-24s │ %-5s │ %-8s │ %-6s │ %-7s │ %-7s │ %-5s │ %-6s │\n",
+			rank, name, tag, region, events, series, games, diff, recent)
+	}
+
+	fmt.Fprintln(w, "└─────┴──────────────────────────┴───────┴──────────┴────────┴─────────┴─────────┴───────┴────────┘")
+
+	return nil
+}
+
+// TeamTableFormatter outputs a single team as a summary followed by its recent results
+type TeamTableFormatter struct{}
+
+func (f *TeamTableFormatter) Format(w io.Writer, team teams.Team) error {
+	// Write team summary
+	fmt.Fprintf(w, "\n%s", team.Name)
+	if team.Shorthand != "" {
+		fmt.Fprintf(w, " (%s)", team.Shorthand)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "ID: %s\n", team.UUID)
+	if team.Location != "" {
+		fmt.Fprintf(w, "Location: %s\n", team.Location)
+	}
+	fmt.Fprintf(w, "Region: %s\n", formatRegions(team))
+	fmt.Fprintf(w, "Series: %d-%d\n", team.SeriesWins, team.SeriesLosses)
+	fmt.Fprintf(w, "Games: %d-%d (%+d)\n", team.GameWins, team.GameLosses, team.GameDifferential)
+
+	names := make([]string, 0, len(team.Tournaments))
+	for _, t := range team.Tournaments {
+		names = append(names, t.Name)
+	}
+	fmt.Fprintf(w, "Tournaments (%d): %s\n", len(names), strings.Join(names, ", "))
+	fmt.Fprintln(w)
+
+	if len(team.Recent) == 0 {
+		fmt.Fprintln(w, "No completed series found")
+		return nil
+	}
+
+	// Write recent results
+	fmt.Fprintln(w, "┌────────────┬───────────────────────────────┬───────────────────────┬───────────────────────┬─────────┬────────┐")
+	fmt.Fprintln(w, "│ Date       │ Tournament                    │ Stage                 │ Opponent              │ Score   │ Result │")
+	fmt.Fprintln(w, "├────────────┼───────────────────────────────┼───────────────────────┼───────────────────────┼─────────┼────────┤")
+
+	for _, result := range team.Recent {
+		date := result.Time.Format("2006-01-02")
+		tournament := truncate(result.TournamentName, 29)
+		stage := truncate(result.Stage, 21)
+		opponent := truncate(result.Opponent, 21)
+		score := fmt.Sprintf("%d - %d", result.Score, result.OpponentScore)
+		outcome := formatOutcome(result)
+
+		fmt.Fprintf(w, "│ %-10s │ %-29s │ %-21s │ %-21s │ %-7s │ %-6s │\n",
+			date, tournament, stage, opponent, score, outcome)
+	}
+
+	fmt.Fprintln(w, "└────────────┴───────────────────────────────┴───────────────────────┴───────────────────────┴─────────┴────────┘")
+
+	return nil
+}
+
+func formatRegions(team teams.Team) string {
+	if len(team.Regions) == 0 {
+		return "-"
+	}
+	regions := make([]string, 0, len(team.Regions))
+	for _, r := range team.Regions {
+		regions = append(regions, string(r))
+	}
+	return strings.Join(regions, ",")
+}
+
+// formatForm returns the outcomes of the results as letters, most recent first
+func formatForm(results []teams.Result) string {
+	var form strings.Builder
+	for _, result := range results {
+		form.WriteString(formatOutcome(result)[:1])
+	}
+	return form.String()
+}
+
+func formatOutcome(result teams.Result) string {
+	if result.Won {
+		return "Win"
+	}
+	if result.Score == result.OpponentScore {
+		return "Draw"
+	}
+	return "Loss"
+}
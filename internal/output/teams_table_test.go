@@ -0,0 +1,87 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/teams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTeam() teams.Team {
+	return teams.Team{
+		UUID:             "team-1",
+		Name:             "Team Vitality",
+		Shorthand:        "VIT",
+		Location:         "FR",
+		Regions:          []domain.Region{domain.RegionEU},
+		Tournaments:      []teams.Tournament{{ID: "t1", Name: "Open 1 EU"}, {ID: "t2", Name: "Major 1"}},
+		SeriesWins:       5,
+		SeriesLosses:     1,
+		GameWins:         17,
+		GameLosses:       8,
+		GameDifferential: 9,
+		Recent: []teams.Result{
+			{TournamentName: "Major 1", Stage: "Grand Final", Time: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), Opponent: "Karmine Corp", Score: 4, OpponentScore: 3, Won: true},
+			{TournamentName: "Major 1", Stage: "Swiss R1", Time: time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC), Opponent: "Team Falcons", Score: 1, OpponentScore: 3},
+		},
+	}
+}
+
+func TestTeamsTableFormatter_Format(t *testing.T) {
+	formatter := &TeamsTableFormatter{}
+
+	t.Run("no teams", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, formatter.Format(&buf, nil))
+		assert.Equal(t, "No teams found\n", buf.String())
+	})
+
+	t.Run("teams", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, formatter.Format(&buf, []teams.Team{testTeam()}))
+
+		out := buf.String()
+		for _, s := range []string{"Team Vitality", "VIT", "EU", "5-1", "17-8", "+9", "WL"} {
+			assert.Contains(t, out, s)
+		}
+	})
+}
+
+func TestTeamTableFormatter_Format(t *testing.T) {
+	formatter := &TeamTableFormatter{}
+
+	var buf bytes.Buffer
+	require.NoError(t, formatter.Format(&buf, testTeam()))
+
+	out := buf.String()
+	for _, s := range []string{"Team Vitality (VIT)", "Series: 5-1", "Games: 17-8 (+9)", "Tournaments (2): Open 1 EU, Major 1", "2026-02-01", "Grand Final", "Karmine Corp", "4 - 3", "Win", "Loss"} {
+		assert.Contains(t, out, s)
+	}
+
+	buf.Reset()
+	team := testTeam()
+	team.Recent = nil
+	require.NoError(t, formatter.Format(&buf, team))
+	assert.Contains(t, buf.String(), "No completed series found")
+}
+
+func TestGetTeamsFormatter(t *testing.T) {
+	for _, format := range []TeamsFormat{TeamsFormatTable, TeamsFormatJSON, TeamsFormatYAML} {
+		formatter, err := GetTeamsFormatter(format)
+		require.NoError(t, err)
+		assert.NotNil(t, formatter)
+
+		single, err := GetTeamFormatter(format)
+		require.NoError(t, err)
+		assert.NotNil(t, single)
+	}
+
+	_, err := GetTeamsFormatter("xml")
+	assert.Error(t, err)
+	_, err = GetTeamFormatter("xml")
+	assert.Error(t, err)
+}
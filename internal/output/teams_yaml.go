@@ -0,0 +1,38 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mgranderath/rlcs-cli/internal/teams"
+	"gopkg.in/yaml.v3"
+)
+
+// TeamsYAMLFormatter outputs teams as YAML
+type TeamsYAMLFormatter struct{}
+
+func (f *TeamsYAMLFormatter) Format(w io.Writer, records []teams.Team) error {
+	return encodeTeamsYAML(w, records)
+}
+
+// TeamYAMLFormatter outputs a single team as YAML
+type TeamYAMLFormatter struct{}
+
+func (f *TeamYAMLFormatter) Format(w io.Writer, team teams.Team) error {
+	return encodeTeamsYAML(w, team)
+}
+
+func encodeTeamsYAML(w io.Writer, v interface{}) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(v); err != nil {
+		return fmt.Errorf("failed to encode teams to YAML: %w", err)
+	}
+
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to close YAML encoder: %w", err)
+	}
+
+	return nil
+}
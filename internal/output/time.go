@@ -0,0 +1,66 @@
+package output
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+)
+
+// currentTime returns the time relative times are computed from, the wall clock if now is nil
+func currentTime(now func() time.Time) time.Time {
+	if now == nil {
+		return time.Now()
+	}
+	return now()
+}
+
+// formatSeriesTime returns the scheduled start of a series in its time zone, or TBD if it has none
+func formatSeriesTime(t time.Time) string {
+	if t.IsZero() {
+		return "TBD"
+	}
+	return t.Format("2006-01-02 15:04")
+}
+
+// formatWhen returns the start of a series relative to now, e.g. "in 2h 10m" or "started 35m ago"
+func formatWhen(match domain.Match, now time.Time) string {
+	start := match.TimeOfSeries
+	if start.IsZero() {
+		return "-"
+	}
+	elapsed := now.Sub(start)
+	switch {
+	case match.IsInProgress() && elapsed > 0:
+		return "started " + formatSpan(elapsed) + " ago"
+	case match.IsInProgress():
+		return "started"
+	case elapsed < 0:
+		return "in " + formatSpan(-elapsed)
+	case match.IsOver():
+		return formatSpan(elapsed) + " ago"
+	}
+	return "due " + formatSpan(elapsed) + " ago"
+}
+
+// formatSpan returns a duration in days, hours and minutes, e.g. "2h 10m" or "3d 4h"
+func formatSpan(d time.Duration) string {
+	d = d.Round(time.Minute)
+	if d < time.Minute {
+		return "<1m"
+	}
+	days := int(d / (24 * time.Hour))
+	hours := int(d % (24 * time.Hour) / time.Hour)
+	minutes := int(d % time.Hour / time.Minute)
+	switch {
+	case days > 0 && hours > 0:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case days > 0:
+		return fmt.Sprintf("%dd", days)
+	case hours > 0 && minutes > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	case hours > 0:
+		return fmt.Sprintf("%dh", hours)
+	}
+	return fmt.Sprintf("%dm", minutes)
+}
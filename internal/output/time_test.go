@@ -0,0 +1,46 @@
+package output
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatSeriesTime(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	start := time.Date(2026, 3, 4, 17, 0, 0, 0, time.UTC)
+	assert.Equal(t, "2026-03-04 17:00", formatSeriesTime(start))
+	assert.Equal(t, "2026-03-04 12:00", formatSeriesTime(start.In(newYork)))
+	assert.Equal(t, "TBD", formatSeriesTime(time.Time{}))
+}
+
+func TestFormatWhen(t *testing.T) {
+	current := time.Date(2026, 3, 4, 18, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) time.Time { return current.Add(d) }
+
+	tests := []struct {
+		name     string
+		match    domain.Match
+		expected string
+	}{
+		{"upcoming", domain.Match{TimeOfSeries: at(2*time.Hour + 10*time.Minute)}, "in 2h 10m"},
+		{"upcoming in days", domain.Match{TimeOfSeries: at(50 * time.Hour)}, "in 2d 2h"},
+		{"upcoming soon", domain.Match{TimeOfSeries: at(20 * time.Second)}, "in <1m"},
+		{"upcoming overdue", domain.Match{TimeOfSeries: at(-5 * time.Minute)}, "due 5m ago"},
+		{"live", domain.Match{TimeOfSeries: at(-35 * time.Minute), IsLive: true}, "started 35m ago"},
+		{"live early", domain.Match{TimeOfSeries: at(5 * time.Minute), IsLive: true}, "started"},
+		{"completed", domain.Match{TimeOfSeries: at(-3 * time.Hour), IsCompleted: true}, "3h ago"},
+		{"between games", domain.Match{TimeOfSeries: at(-time.Hour), Type: "BO5", TeamAScore: 2, TeamBScore: 1, IsCompleted: true}, "started 1h ago"},
+		{"no time", domain.Match{}, "-"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, formatWhen(tt.match, current))
+		})
+	}
+}
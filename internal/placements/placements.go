@@ -0,0 +1,284 @@
+// Package placements derives the final placements of a tournament from the flow of its brackets
+package placements
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/standings"
+)
+
+// Status describes whether a team is still playing
+type Status string
+
+const (
+	StatusWinner     Status = "Winner"
+	StatusEliminated Status = "Eliminated"
+	StatusAlive      Status = "Alive"
+)
+
+// Placement is the final placement range of a team.
+// Teams that are still alive share the range of placements that is left.
+type Placement struct {
+	From      int
+	To        int
+	Placement string
+	TeamUUID  string
+	Team      string
+	Shorthand string
+	Status    Status
+	// Bracket is the label of the bracket the team was eliminated in or won
+	Bracket string
+	// Stage is the name of the series the team was eliminated in or won
+	Stage string
+}
+
+// group is a set of teams that were eliminated at the same point of the tournament
+type group struct {
+	// bracket is the position of the bracket in the tournament
+	bracket int
+	// score orders groups within a bracket, higher is better
+	score int
+	// slots is the number of teams that are eliminated at this point once the tournament is over
+	slots int
+	teams []Placement
+}
+
+// Compute assigns every team of the brackets a placement range.
+// Brackets are ordered by their index, teams eliminated in later brackets place higher.
+// In elimination brackets a team is eliminated when it loses a series without a loser
+// destination; the closer the series is to the final (following winner destinations),
+// the better the placement. In Swiss and round-robin brackets teams flagged as eliminated
+// are placed by their series wins.
+func Compute(brackets []domain.Bracket) []Placement {
+	ordered := make([]domain.Bracket, len(brackets))
+	copy(ordered, brackets)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Index < ordered[j].Index
+	})
+
+	teams := make(map[string]domain.MatchTeam)
+	order := make([]string, 0)
+	for _, bracket := range ordered {
+		for _, match := range bracket.Matches {
+			for _, team := range []domain.MatchTeam{match.TeamA, match.TeamB} {
+				if team.UUID == "" {
+					continue
+				}
+				if _, ok := teams[team.UUID]; !ok {
+					order = append(order, team.UUID)
+				}
+				teams[team.UUID] = team
+			}
+		}
+	}
+
+	placed := make(map[string]bool)
+	groups := make(map[[2]int]*group)
+	add := func(bracket, score, slots int, p Placement) {
+		if placed[p.TeamUUID] {
+			return
+		}
+		placed[p.TeamUUID] = true
+
+		key := [2]int{bracket, score}
+		g, ok := groups[key]
+		if !ok {
+			g = &group{bracket: bracket, score: score, slots: slots}
+			groups[key] = g
+		}
+		g.teams = append(g.teams, p)
+	}
+
+	// Later brackets decide placements first, a team only counts where it was eliminated last
+	var winners []Placement
+	lastElimination := lastEliminationBracket(ordered)
+	for i := len(ordered) - 1; i >= 0; i-- {
+		bracket := ordered[i]
+
+		if standings.Supported(bracket) {
+			table := standings.ComputeBracket(bracket)
+			for _, s := range table.Standings {
+				if !s.IsEliminated {
+					continue
+				}
+				add(i, s.SeriesWins*100-s.SeriesLosses, 0, placement(teams[s.TeamUUID], StatusEliminated, bracket.Label, ""))
+			}
+			continue
+		}
+
+		distances := winnerDistances(bracket)
+		slots := make(map[int]int)
+		for _, match := range bracket.Matches {
+			if match.LoserGoesTo == nil {
+				slots[distances[match.UUID]]++
+			}
+		}
+
+		for _, match := range bracket.Matches {
+			if !match.IsCompleted || match.TeamAScore == match.TeamBScore {
+				continue
+			}
+			winner, loser := match.TeamA, match.TeamB
+			if match.TeamBScore > match.TeamAScore {
+				winner, loser = loser, winner
+			}
+
+			if match.LoserGoesTo == nil && loser.UUID != "" {
+				distance := distances[match.UUID]
+				add(i, -distance, slots[distance], placement(teams[loser.UUID], StatusEliminated, bracket.Label, match.Name))
+			}
+			if i == lastElimination && match.WinnerGoesTo == nil && winner.UUID != "" && !placed[winner.UUID] {
+				placed[winner.UUID] = true
+				winners = append(winners, placement(teams[winner.UUID], StatusWinner, bracket.Label, match.Name))
+			}
+		}
+	}
+
+	sorted := make([]*group, 0, len(groups))
+	for _, g := range groups {
+		sorted = append(sorted, g)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].bracket != sorted[j].bracket {
+			return sorted[i].bracket < sorted[j].bracket
+		}
+		return sorted[i].score < sorted[j].score
+	})
+
+	var alive []Placement
+	for _, id := range order {
+		if !placed[id] {
+			alive = append(alive, placement(teams[id], StatusAlive, "", ""))
+		}
+	}
+
+	// Assign ranges from the bottom, stages that are not over yet reserve the slots of the
+	// teams they will still eliminate and alive teams may still end up in them
+	ranked := make([][]Placement, 0, len(sorted)+2)
+	bottom := len(order)
+	above := len(winners)
+	for _, g := range sorted {
+		above += len(g.teams)
+	}
+	worstOpen := 0
+	for _, g := range sorted {
+		above -= len(g.teams)
+		size := g.slots
+		if size < len(g.teams) {
+			size = len(g.teams)
+		}
+		from, to := bottom-size+1, bottom
+		if from <= above {
+			from = above + 1
+		}
+		if size > len(g.teams) && worstOpen == 0 {
+			worstOpen = to
+		}
+		ranked = append(ranked, withRange(g.teams, from, to))
+		bottom = from - 1
+	}
+
+	aliveTo := bottom
+	if worstOpen > aliveTo {
+		aliveTo = worstOpen
+	}
+	if aliveTo < len(winners)+len(alive) {
+		aliveTo = len(winners) + len(alive)
+	}
+	ranked = append(ranked, withRange(alive, len(winners)+1, aliveTo))
+	ranked = append(ranked, withRange(winners, 1, len(winners)))
+
+	result := make([]Placement, 0, len(order))
+	for i := len(ranked) - 1; i >= 0; i-- {
+		result = append(result, ranked[i]...)
+	}
+	return result
+}
+
+// withRange assigns a placement range to teams and sorts them by name
+func withRange(teams []Placement, from, to int) []Placement {
+	sort.SliceStable(teams, func(i, j int) bool {
+		return strings.ToLower(teams[i].Team) < strings.ToLower(teams[j].Team)
+	})
+	for i := range teams {
+		teams[i].From, teams[i].To = from, to
+		teams[i].Placement = FormatRange(from, to)
+	}
+	return teams
+}
+
+// FormatRange formats a placement range, e.g. "1st" or "5th–8th"
+func FormatRange(from, to int) string {
+	if from == to {
+		return ordinal(from)
+	}
+	return ordinal(from) + "–" + ordinal(to)
+}
+
+func ordinal(n int) string {
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
+
+func placement(team domain.MatchTeam, status Status, bracket, stage string) Placement {
+	return Placement{
+		TeamUUID:  team.UUID,
+		Team:      team.Name,
+		Shorthand: team.Shorthand,
+		Status:    status,
+		Bracket:   bracket,
+		Stage:     stage,
+	}
+}
+
+// lastEliminationBracket returns the position of the last bracket that is not Swiss or round robin,
+// its final decides the winner of the tournament. It returns -1 if there is none.
+func lastEliminationBracket(brackets []domain.Bracket) int {
+	for i := len(brackets) - 1; i >= 0; i-- {
+		if !standings.Supported(brackets[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// winnerDistances returns the number of series the winner of each series of a bracket
+// still has to win to win the bracket. Destinations outside of the bracket end the path.
+func winnerDistances(bracket domain.Bracket) map[string]int {
+	byUUID := make(map[string]domain.Match, len(bracket.Matches))
+	for _, match := range bracket.Matches {
+		byUUID[match.UUID] = match
+	}
+
+	distances := make(map[string]int, len(bracket.Matches))
+	for _, match := range bracket.Matches {
+		distance := 0
+		current := match
+		// Bound the walk by the number of series so that cycles in the data terminate
+		for steps := 0; steps < len(bracket.Matches); steps++ {
+			if current.WinnerGoesTo == nil {
+				break
+			}
+			next, ok := byUUID[current.WinnerGoesTo.SeriesUUID]
+			if !ok {
+				break
+			}
+			distance++
+			current = next
+		}
+		distances[match.UUID] = distance
+	}
+	return distances
+}
@@ -0,0 +1,152 @@
+package placements
+
+import (
+	"testing"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func team(id string) domain.MatchTeam {
+	return domain.MatchTeam{UUID: id, Name: "Team " + id, Shorthand: id}
+}
+
+func series(id, a, b string, scoreA, scoreB int, winnerGoesTo string) domain.Match {
+	match := domain.Match{
+		UUID:        id,
+		Name:        id,
+		TeamA:       team(a),
+		TeamB:       team(b),
+		TeamAScore:  scoreA,
+		TeamBScore:  scoreB,
+		IsCompleted: scoreA != scoreB,
+	}
+	if winnerGoesTo != "" {
+		match.WinnerGoesTo = &domain.BracketDestination{SeriesUUID: winnerGoesTo}
+	}
+	return match
+}
+
+// ranges maps team UUIDs to their placement
+func ranges(results []Placement) map[string]string {
+	m := make(map[string]string, len(results))
+	for _, p := range results {
+		m[p.TeamUUID] = p.Placement
+	}
+	return m
+}
+
+func TestCompute_SingleElimination(t *testing.T) {
+	swiss := domain.Bracket{
+		Index:  0,
+		Label:  "Swiss",
+		Format: "swiss-4",
+		Matches: []domain.Match{
+			series("s1", "A", "I", 3, 0, ""),
+			series("s2", "B", "J", 3, 1, ""),
+			series("s3", "I", "J", 3, 2, ""),
+		},
+	}
+	swiss.Matches[2].TeamA.IsEliminated = true
+	swiss.Matches[2].TeamB.IsEliminated = true
+
+	playoffs := domain.Bracket{
+		Index:  1,
+		Label:  "Playoffs",
+		Format: "single-elim-8",
+		Matches: []domain.Match{
+			series("qf1", "A", "H", 3, 0, "sf1"),
+			series("qf2", "B", "G", 3, 1, "sf1"),
+			series("qf3", "C", "F", 3, 2, "sf2"),
+			series("qf4", "D", "E", 0, 3, "sf2"),
+			series("sf1", "A", "B", 4, 2, "final"),
+			series("sf2", "C", "E", 4, 3, "final"),
+			series("final", "A", "C", 4, 1, ""),
+		},
+	}
+
+	// Brackets are ordered by index, not by their position in the slice
+	results := Compute([]domain.Bracket{playoffs, swiss})
+	require.Len(t, results, 10)
+
+	assert.Equal(t, map[string]string{
+		"A": "1st",
+		"C": "2nd",
+		"B": "3rd–4th",
+		"E": "3rd–4th",
+		"D": "5th–8th",
+		"F": "5th–8th",
+		"G": "5th–8th",
+		"H": "5th–8th",
+		"I": "9th",
+		"J": "10th",
+	}, ranges(results))
+
+	assert.Equal(t, "A", results[0].TeamUUID)
+	assert.Equal(t, StatusWinner, results[0].Status)
+	assert.Equal(t, "final", results[0].Stage)
+	assert.Equal(t, []string{"B", "E"}, []string{results[2].TeamUUID, results[3].TeamUUID})
+	assert.Equal(t, 3, results[2].From)
+	assert.Equal(t, 4, results[2].To)
+	assert.Equal(t, "Playoffs", results[2].Bracket)
+	assert.Equal(t, "Swiss", results[9].Bracket)
+	assert.Equal(t, StatusEliminated, results[9].Status)
+}
+
+func TestCompute_InProgress(t *testing.T) {
+	bracket := domain.Bracket{
+		Label:  "Playoffs",
+		Format: "single-elim-4",
+		Matches: []domain.Match{
+			series("sf1", "A", "B", 4, 2, "final"),
+			series("sf2", "C", "D", 0, 0, "final"),
+			{UUID: "final", TeamA: team("A"), TeamB: domain.MatchTeam{Name: "TBD"}},
+		},
+	}
+
+	results := Compute([]domain.Bracket{bracket})
+	require.Len(t, results, 4)
+
+	// The other semifinal still eliminates a team, alive teams may end up in its range
+	assert.Equal(t, map[string]string{
+		"A": "1st–4th",
+		"C": "1st–4th",
+		"D": "1st–4th",
+		"B": "3rd–4th",
+	}, ranges(results))
+	assert.Equal(t, StatusAlive, results[0].Status)
+}
+
+func TestCompute_CycleTerminates(t *testing.T) {
+	bracket := domain.Bracket{
+		Format: "single-elim-4",
+		Matches: []domain.Match{
+			series("a", "A", "B", 3, 0, "b"),
+			series("b", "C", "D", 3, 0, "a"),
+		},
+	}
+
+	results := Compute([]domain.Bracket{bracket})
+	assert.Len(t, results, 4)
+}
+
+func TestFormatRange(t *testing.T) {
+	tests := []struct {
+		from, to int
+		expected string
+	}{
+		{1, 1, "1st"},
+		{2, 2, "2nd"},
+		{3, 4, "3rd–4th"},
+		{11, 12, "11th–12th"},
+		{13, 13, "13th"},
+		{21, 22, "21st–22nd"},
+		{101, 101, "101st"},
+		{111, 111, "111th"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, FormatRange(tt.from, tt.to))
+	}
+}
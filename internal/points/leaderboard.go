@@ -0,0 +1,119 @@
+package points
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/placements"
+)
+
+// Standing is the circuit points total of a team
+type Standing struct {
+	Rank      int
+	TeamUUID  string
+	Team      string
+	Shorthand string
+	// Regions lists the regions of the regional tournaments the team played in
+	Regions []domain.Region
+	Points  int
+	// Provisional is true if the team is still playing a tournament, its points can only grow
+	Provisional bool
+	// Events lists the tournaments the team placed in, in the order they started
+	Events []Event
+}
+
+// Event is the placement of a team in a single tournament and the points it was awarded
+type Event struct {
+	TournamentID   string
+	TournamentName string
+	Type           domain.TournamentType
+	Placement      string
+	Points         int
+	// Provisional is true if the team is still alive in the tournament
+	Provisional bool
+}
+
+// HasRegion returns true if the team played a regional tournament of the region (case-insensitive)
+func (s *Standing) HasRegion(region string) bool {
+	for _, r := range s.Regions {
+		if strings.EqualFold(string(r), region) {
+			return true
+		}
+	}
+	return false
+}
+
+// Compute totals the points of all teams placed in the tournaments.
+// Teams sharing a placement range are awarded the points of its lowest placement, for teams that
+// are still alive these are the points they are guaranteed. Teams are sorted by points and name,
+// teams with the same points share a rank.
+func Compute(tournaments []domain.TournamentBrackets, table Table) []Standing {
+	ordered := make([]domain.TournamentBrackets, len(tournaments))
+	copy(ordered, tournaments)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Tournament.StartDate.Before(ordered[j].Tournament.StartDate)
+	})
+
+	entries := make(map[string]*Standing)
+	regions := make(map[string]map[domain.Region]bool)
+	for _, t := range ordered {
+		for _, p := range placements.Compute(t.Brackets) {
+			s, ok := entries[p.TeamUUID]
+			if !ok {
+				s = &Standing{TeamUUID: p.TeamUUID}
+				entries[p.TeamUUID] = s
+				regions[p.TeamUUID] = make(map[domain.Region]bool)
+			}
+			// Name the team after its most recent tournament
+			s.Team = p.Team
+			s.Shorthand = p.Shorthand
+			if t.Tournament.Region != domain.RegionNone {
+				regions[p.TeamUUID][t.Tournament.Region] = true
+			}
+
+			event := Event{
+				TournamentID:   t.Tournament.ID,
+				TournamentName: t.Tournament.Name,
+				Type:           t.Tournament.Type,
+				Placement:      p.Placement,
+				Points:         table.Points(t.Tournament.Type, p.To),
+				Provisional:    p.Status == placements.StatusAlive,
+			}
+			s.Points += event.Points
+			s.Provisional = s.Provisional || event.Provisional
+			s.Events = append(s.Events, event)
+		}
+	}
+
+	standings := make([]Standing, 0, len(entries))
+	for id, s := range entries {
+		for region := range regions[id] {
+			s.Regions = append(s.Regions, region)
+		}
+		sort.Slice(s.Regions, func(i, j int) bool {
+			return s.Regions[i] < s.Regions[j]
+		})
+		standings = append(standings, *s)
+	}
+
+	sort.Slice(standings, func(i, j int) bool {
+		if standings[i].Points != standings[j].Points {
+			return standings[i].Points > standings[j].Points
+		}
+		return strings.ToLower(standings[i].Team) < strings.ToLower(standings[j].Team)
+	})
+	Rank(standings)
+
+	return standings
+}
+
+// Rank assigns ranks to standings sorted by points, teams with the same points share a rank
+func Rank(standings []Standing) {
+	for i := range standings {
+		standings[i].Rank = i + 1
+		if i > 0 && standings[i].Points == standings[i-1].Points {
+			standings[i].Rank = standings[i-1].Rank
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package points
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func team(id string) domain.MatchTeam {
+	return domain.MatchTeam{UUID: id, Name: "Team " + id, Shorthand: id}
+}
+
+// singleElimination returns a bracket of four teams: a beats b, c beats d, the final is given
+func singleElimination(final domain.Match) domain.Bracket {
+	final.UUID = "final"
+	final.Name = "Final"
+	next := &domain.BracketDestination{SeriesUUID: "final"}
+	return domain.Bracket{
+		Format: "single-elim-4",
+		Matches: []domain.Match{
+			{UUID: "sf1", Name: "Semifinal 1", TeamA: team("A"), TeamB: team("B"), TeamAScore: 4, TeamBScore: 1, IsCompleted: true, WinnerGoesTo: next},
+			{UUID: "sf2", Name: "Semifinal 2", TeamA: team("C"), TeamB: team("D"), TeamAScore: 4, TeamBScore: 3, IsCompleted: true, WinnerGoesTo: next},
+			final,
+		},
+	}
+}
+
+func TestCompute(t *testing.T) {
+	table := Table{
+		domain.TypeOpen: {
+			{From: 1, To: 1, Points: 100},
+			{From: 2, To: 2, Points: 60},
+			{From: 3, To: 4, Points: 30},
+		},
+		domain.TypeMajor: {
+			{From: 1, To: 1, Points: 300},
+			{From: 2, To: 2, Points: 200},
+			{From: 3, To: 4, Points: 100},
+		},
+	}
+
+	open := domain.TournamentBrackets{
+		Tournament: domain.Tournament{ID: "open", Name: "EU Open", Type: domain.TypeOpen, Region: domain.RegionEU, StartDate: time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)},
+		Brackets:   []domain.Bracket{singleElimination(domain.Match{TeamA: team("A"), TeamB: team("C"), TeamAScore: 4, TeamBScore: 2, IsCompleted: true})},
+	}
+	// The major is still running, A and C are alive in the final
+	major := domain.TournamentBrackets{
+		Tournament: domain.Tournament{ID: "major", Name: "Major", Type: domain.TypeMajor, StartDate: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		Brackets:   []domain.Bracket{singleElimination(domain.Match{TeamA: team("A"), TeamB: team("C")})},
+	}
+
+	standings := Compute([]domain.TournamentBrackets{major, open}, table)
+	require.Len(t, standings, 4)
+
+	a, c := standings[0], standings[1]
+	assert.Equal(t, "A", a.TeamUUID)
+	assert.Equal(t, 1, a.Rank)
+	assert.Equal(t, 100+200, a.Points)
+	assert.True(t, a.Provisional)
+	assert.Equal(t, []domain.Region{domain.RegionEU}, a.Regions)
+	assert.True(t, a.HasRegion("eu"))
+	assert.False(t, a.HasRegion("NA"))
+
+	// Events are listed in the order the tournaments started
+	require.Len(t, a.Events, 2)
+	assert.Equal(t, Event{TournamentID: "open", TournamentName: "EU Open", Type: domain.TypeOpen, Placement: "1st", Points: 100}, a.Events[0])
+	assert.Equal(t, Event{TournamentID: "major", TournamentName: "Major", Type: domain.TypeMajor, Placement: "1st–2nd", Points: 200, Provisional: true}, a.Events[1])
+
+	assert.Equal(t, "C", c.TeamUUID)
+	assert.Equal(t, 60+200, c.Points)
+
+	// B and D share both placements and the rank
+	b, d := standings[2], standings[3]
+	assert.Equal(t, []string{"B", "D"}, []string{b.TeamUUID, d.TeamUUID})
+	assert.Equal(t, 30+100, b.Points)
+	assert.Equal(t, 3, b.Rank)
+	assert.Equal(t, 3, d.Rank)
+	assert.False(t, b.Provisional)
+}
+
+func TestRank(t *testing.T) {
+	standings := []Standing{{Points: 10}, {Points: 5}, {Points: 5}, {Points: 1}}
+	Rank(standings)
+
+	ranks := make([]int, 0, len(standings))
+	for _, s := range standings {
+		ranks = append(ranks, s.Rank)
+	}
+	assert.Equal(t, []int{1, 2, 2, 4}, ranks)
+}
@@ -0,0 +1,131 @@
+// Package points computes circuit points leaderboards from the placements of tournaments
+package points
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"gopkg.in/yaml.v3"
+)
+
+// Award is the number of points awarded for a range of placements
+type Award struct {
+	From   int
+	To     int
+	Points int
+}
+
+// Table holds the points awarded per tournament type, awards are ordered by placement
+type Table map[domain.TournamentType][]Award
+
+// tournamentTypes lists the tournament types a points table can award points for
+var tournamentTypes = []domain.TournamentType{
+	domain.TypeOpen,
+	domain.TypeMajor,
+	domain.TypeWorldChampionship,
+	domain.TypeKickoff,
+}
+
+// Load reads a points table from a YAML file
+func Load(path string) (Table, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read points table: %w", err)
+	}
+
+	table, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse points table %s: %w", path, err)
+	}
+	return table, nil
+}
+
+// Parse parses a points table. The YAML document maps tournament types to placements
+// (a single placement like "1" or a range like "5-8") and the points awarded for them:
+//
+//	Open:
+//	  1: 301
+//	  3-4: 195
+func Parse(data []byte) (Table, error) {
+	var raw map[string]map[string]int
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	table := make(Table, len(raw))
+	for name, placements := range raw {
+		tournamentType, err := parseType(name)
+		if err != nil {
+			return nil, err
+		}
+
+		awards := make([]Award, 0, len(placements))
+		for placement, points := range placements {
+			from, to, err := parseRange(placement)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			if points < 0 {
+				return nil, fmt.Errorf("%s: points for placement %s cannot be negative", name, placement)
+			}
+			awards = append(awards, Award{From: from, To: to, Points: points})
+		}
+
+		sort.Slice(awards, func(i, j int) bool {
+			return awards[i].From < awards[j].From
+		})
+		for i := 1; i < len(awards); i++ {
+			if awards[i].From <= awards[i-1].To {
+				return nil, fmt.Errorf("%s: placements %d and %d overlap", name, awards[i-1].From, awards[i].From)
+			}
+		}
+		table[tournamentType] = awards
+	}
+	return table, nil
+}
+
+// Points returns the points awarded for a placement in a tournament of the given type.
+// Placements without an award are worth no points.
+func (t Table) Points(tournamentType domain.TournamentType, placement int) int {
+	for _, award := range t[tournamentType] {
+		if placement >= award.From && placement <= award.To {
+			return award.Points
+		}
+	}
+	return 0
+}
+
+func parseType(name string) (domain.TournamentType, error) {
+	for _, t := range tournamentTypes {
+		if strings.EqualFold(name, string(t)) {
+			return t, nil
+		}
+	}
+
+	names := make([]string, 0, len(tournamentTypes))
+	for _, t := range tournamentTypes {
+		names = append(names, string(t))
+	}
+	return "", fmt.Errorf("unknown tournament type %q, expected one of: %s", name, strings.Join(names, ", "))
+}
+
+func parseRange(placement string) (int, int, error) {
+	fromValue, toValue, isRange := strings.Cut(placement, "-")
+	if !isRange {
+		toValue = fromValue
+	}
+
+	from, err := strconv.Atoi(strings.TrimSpace(fromValue))
+	if err != nil || from < 1 {
+		return 0, 0, fmt.Errorf("invalid placement %q", placement)
+	}
+	to, err := strconv.Atoi(strings.TrimSpace(toValue))
+	if err != nil || to < from {
+		return 0, 0, fmt.Errorf("invalid placement %q", placement)
+	}
+	return from, to, nil
+}
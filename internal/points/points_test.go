@@ -0,0 +1,80 @@
+package points
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	table, err := Parse([]byte(`
+Major:
+  1: 300
+  2: 240
+  3-4: 180
+  5 - 8: 120
+open:
+  1: 100
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, []Award{
+		{From: 1, To: 1, Points: 300},
+		{From: 2, To: 2, Points: 240},
+		{From: 3, To: 4, Points: 180},
+		{From: 5, To: 8, Points: 120},
+	}, table[domain.TypeMajor])
+	assert.Equal(t, []Award{{From: 1, To: 1, Points: 100}}, table[domain.TypeOpen])
+
+	assert.Equal(t, 300, table.Points(domain.TypeMajor, 1))
+	assert.Equal(t, 180, table.Points(domain.TypeMajor, 4))
+	assert.Equal(t, 120, table.Points(domain.TypeMajor, 6))
+	assert.Equal(t, 0, table.Points(domain.TypeMajor, 9))
+	assert.Equal(t, 0, table.Points(domain.TypeKickoff, 1))
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected string
+	}{
+		{"unknown type", "Regional:\n  1: 10\n", `unknown tournament type "Regional", expected one of: Open, Major, WorldChampionship, Kickoff`},
+		{"invalid placement", "Open:\n  first: 10\n", `Open: invalid placement "first"`},
+		{"reversed range", "Open:\n  4-3: 10\n", `Open: invalid placement "4-3"`},
+		{"zero placement", "Open:\n  0: 10\n", `Open: invalid placement "0"`},
+		{"negative points", "Open:\n  1: -10\n", "Open: points for placement 1 cannot be negative"},
+		{"overlap", "Open:\n  1-4: 10\n  3-4: 5\n", "Open: placements 1 and 3 overlap"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.data))
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+
+	_, err := Parse([]byte("Open: [\n"))
+	assert.Error(t, err)
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := Load(filepath.Join(dir, "missing.yaml"))
+	assert.ErrorContains(t, err, "failed to read points table")
+
+	path := filepath.Join(dir, "points.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("Kickoff:\n  1: 50\n"), 0o644))
+	table, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, 50, table.Points(domain.TypeKickoff, 1))
+
+	require.NoError(t, os.WriteFile(path, []byte("Kickoff:\n  x: 50\n"), 0o644))
+	_, err = Load(path)
+	assert.ErrorContains(t, err, "failed to parse points table")
+}
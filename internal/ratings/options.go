@@ -0,0 +1,83 @@
+package ratings
+
+import (
+	"fmt"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+)
+
+// System is a rating system
+type System string
+
+const (
+	SystemElo     System = "elo"
+	SystemGlicko2 System = "glicko2"
+)
+
+const (
+	// DefaultKFactor is the Elo K-factor used if none is configured
+	DefaultKFactor = 32
+	// DefaultInitial is the rating of teams that have not played yet
+	DefaultInitial = 1500
+)
+
+// Options configure the rating engine
+type Options struct {
+	System System
+	// KFactor is the maximum rating change of a single Elo result
+	KFactor float64
+	// ByGame rates the individual games of a series instead of the series result
+	ByGame bool
+	// Initial is the rating of teams that have not played yet
+	Initial float64
+	// Seeds holds the initial rating of teams whose first tournament was a regional one of the region
+	Seeds map[domain.Region]float64
+}
+
+// DefaultOptions returns the options of series-based Elo ratings
+func DefaultOptions() Options {
+	return Options{
+		System:  SystemElo,
+		KFactor: DefaultKFactor,
+		Initial: DefaultInitial,
+	}
+}
+
+// Validate checks the options
+func (o Options) Validate() error {
+	switch o.System {
+	case SystemElo, SystemGlicko2:
+	default:
+		return fmt.Errorf("unknown rating system %q, must be one of: elo, glicko2", o.System)
+	}
+	if o.KFactor <= 0 {
+		return fmt.Errorf("k-factor must be positive")
+	}
+	if o.Initial <= 0 {
+		return fmt.Errorf("initial rating must be positive")
+	}
+	for region, seed := range o.Seeds {
+		if seed <= 0 {
+			return fmt.Errorf("seed rating of region %s must be positive", region)
+		}
+	}
+	return nil
+}
+
+// Unrated returns the rating of a team that has not played yet
+func (o Options) Unrated() Rating {
+	r := Rating{Rating: o.Initial}
+	if o.System == SystemGlicko2 {
+		r.Deviation = glickoInitialDeviation
+		r.Volatility = glickoInitialVolatility
+	}
+	return r
+}
+
+// initial returns the initial rating of a team first seen in a tournament of the region
+func (o Options) initial(region domain.Region) float64 {
+	if seed, ok := o.Seeds[region]; ok && region != domain.RegionNone {
+		return seed
+	}
+	return o.Initial
+}
@@ -0,0 +1,287 @@
+// Package ratings computes Elo and Glicko-2 team ratings by replaying historical results
+package ratings
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/teams"
+)
+
+// Rating is the current rating of a team
+type Rating struct {
+	Rank      int
+	TeamUUID  string
+	Team      string
+	Shorthand string
+	// Region is the region of the regional tournament the team was first seen in, used for seeding
+	Region domain.Region
+	// Regions lists the regions of the regional tournaments the team played in
+	Regions []domain.Region
+	Rating  float64
+	// Deviation is the Glicko-2 rating deviation, it is zero for Elo ratings
+	Deviation float64
+	// Volatility is the Glicko-2 rating volatility, it is zero for Elo ratings
+	Volatility   float64
+	SeriesWins   int
+	SeriesLosses int
+	GameWins     int
+	GameLosses   int
+	LastPlayed   time.Time
+	// History lists the rating change of every series the team played, oldest first
+	History []Change
+}
+
+// Change is the rating change of a team caused by a single series
+type Change struct {
+	Time           time.Time
+	TournamentID   string
+	TournamentName string
+	MatchUUID      string
+	Stage          string
+	Opponent       string
+	OpponentRating float64
+	Score          int
+	OpponentScore  int
+	Won            bool
+	Before         float64
+	After          float64
+	// Deviation and OpponentDeviation are the Glicko-2 rating deviations before the series, zero for Elo ratings
+	Deviation         float64
+	OpponentDeviation float64
+}
+
+// HasRegion returns true if the team played a regional tournament of the region (case-insensitive)
+func (r *Rating) HasRegion(region string) bool {
+	for _, reg := range r.Regions {
+		if strings.EqualFold(string(reg), region) {
+			return true
+		}
+	}
+	return false
+}
+
+// series is a finished series of a tournament
+type series struct {
+	tournament domain.Tournament
+	match      domain.Match
+}
+
+// Replay rates all teams by replaying the finished series of the tournaments in chronological
+// order of their start time. Series without a winner or with TBD participants are skipped.
+// With ByGame every game with a score counts as a result, series without game scores fall back
+// to their series score. Ratings are sorted from highest to lowest.
+func Replay(tournaments []domain.TournamentMatches, opts Options) []Rating {
+	var all []series
+	for _, t := range tournaments {
+		for _, match := range t.Matches {
+			if !match.IsOver() || match.TeamAScore == match.TeamBScore ||
+				match.TeamA.UUID == "" || match.TeamB.UUID == "" {
+				continue
+			}
+			all = append(all, series{tournament: t.Tournament, match: match})
+		}
+	}
+	sort.SliceStable(all, func(i, j int) bool {
+		return all[i].match.TimeOfSeries.Before(all[j].match.TimeOfSeries)
+	})
+
+	entries := make(map[string]*Rating)
+	regions := make(map[string]map[domain.Region]bool)
+	entry := func(team domain.MatchTeam, region domain.Region) *Rating {
+		r, ok := entries[team.UUID]
+		if !ok {
+			unrated := opts.Unrated()
+			r = &unrated
+			r.TeamUUID = team.UUID
+			r.Region = region
+			r.Rating = opts.initial(region)
+			entries[team.UUID] = r
+			regions[team.UUID] = make(map[domain.Region]bool)
+		}
+		// Name the team after its most recent series
+		r.Team = team.Name
+		r.Shorthand = team.Shorthand
+		if region != domain.RegionNone {
+			regions[team.UUID][region] = true
+		}
+		return r
+	}
+
+	for _, s := range all {
+		match := s.match
+		a := entry(match.TeamA, s.tournament.Region)
+		b := entry(match.TeamB, s.tournament.Region)
+		beforeA, beforeB := *a, *b
+
+		for _, aWon := range results(match, opts.ByGame) {
+			score := 0.0
+			if aWon {
+				score = 1
+			}
+			rate(a, b, score, opts)
+		}
+
+		aWon := match.TeamAScore > match.TeamBScore
+		record(a, b, s, match.TeamAScore, match.TeamBScore, aWon, beforeA, beforeB)
+		record(b, a, s, match.TeamBScore, match.TeamAScore, !aWon, beforeB, beforeA)
+	}
+
+	ratings := make([]Rating, 0, len(entries))
+	for id, r := range entries {
+		for region := range regions[id] {
+			r.Regions = append(r.Regions, region)
+		}
+		sort.Slice(r.Regions, func(i, j int) bool {
+			return r.Regions[i] < r.Regions[j]
+		})
+		ratings = append(ratings, *r)
+	}
+
+	sort.Slice(ratings, func(i, j int) bool {
+		if ratings[i].Rating != ratings[j].Rating {
+			return ratings[i].Rating > ratings[j].Rating
+		}
+		return strings.ToLower(ratings[i].Team) < strings.ToLower(ratings[j].Team)
+	})
+	Rank(ratings)
+
+	return ratings
+}
+
+// Rank assigns ranks to ratings sorted from highest to lowest
+func Rank(ratings []Rating) {
+	for i := range ratings {
+		ratings[i].Rank = i + 1
+	}
+}
+
+// Expected returns the probability that team a beats team b in a single result of the kind
+// the ratings were computed from (a series, or a game with Options.ByGame)
+func Expected(a, b Rating, system System) float64 {
+	if system == SystemGlicko2 {
+		pa := toGlicko(a.Rating, a.Deviation, a.Volatility)
+		pb := toGlicko(b.Rating, b.Deviation, b.Volatility)
+		// The uncertainty of both ratings flattens the expectation
+		pb.phi = math.Sqrt(pa.phi*pa.phi + pb.phi*pb.phi)
+		return glickoExpected(pa, pb)
+	}
+	return eloExpected(a.Rating, b.Rating)
+}
+
+// Find returns the ratings of the teams matching query.
+// An exact UUID match wins over exact name or shorthand matches, which win over partial ones.
+func Find(ratings []Rating, query string) []Rating {
+	for _, r := range ratings {
+		if r.TeamUUID == query {
+			return []Rating{r}
+		}
+	}
+
+	var exact, partial []Rating
+	for _, r := range ratings {
+		if strings.EqualFold(r.Team, query) || strings.EqualFold(r.Shorthand, query) {
+			exact = append(exact, r)
+		} else if teams.Matches(domain.MatchTeam{Name: r.Team, Shorthand: r.Shorthand}, query) {
+			partial = append(partial, r)
+		}
+	}
+	if len(exact) > 0 {
+		return exact
+	}
+	return partial
+}
+
+// results returns the results of a series from the point of view of team A, true for a win.
+// Rocket League games cannot end in a draw, games with equal scores have not been played.
+func results(match domain.Match, byGame bool) []bool {
+	if !byGame {
+		return []bool{match.TeamAScore > match.TeamBScore}
+	}
+
+	var games []bool
+	for _, game := range match.Maps {
+		if game.TeamAScore != game.TeamBScore {
+			games = append(games, game.TeamAScore > game.TeamBScore)
+		}
+	}
+	if len(games) > 0 {
+		return games
+	}
+
+	// Without game scores only the number of games won is known
+	for i := 0; i < match.TeamAScore; i++ {
+		games = append(games, true)
+	}
+	for i := 0; i < match.TeamBScore; i++ {
+		games = append(games, false)
+	}
+	return games
+}
+
+// rate updates the ratings of both teams after a single result, score is 1 if a won
+func rate(a, b *Rating, score float64, opts Options) {
+	if opts.System == SystemGlicko2 {
+		ga := toGlicko(a.Rating, a.Deviation, a.Volatility)
+		gb := toGlicko(b.Rating, b.Deviation, b.Volatility)
+		na, nb := glickoUpdate(ga, gb, score), glickoUpdate(gb, ga, 1-score)
+		a.Rating, a.Deviation, a.Volatility = na.rating(), na.deviation(), na.sigma
+		b.Rating, b.Deviation, b.Volatility = nb.rating(), nb.deviation(), nb.sigma
+		return
+	}
+
+	ra, rb := a.Rating, b.Rating
+	a.Rating = eloUpdate(ra, rb, score, opts.KFactor)
+	b.Rating = eloUpdate(rb, ra, 1-score, opts.KFactor)
+}
+
+// record adds a series to the record and history of team r
+func record(r, opponent *Rating, s series, score, opponentScore int, won bool, before, opponentBefore Rating) {
+	if won {
+		r.SeriesWins++
+	} else {
+		r.SeriesLosses++
+	}
+	r.GameWins += score
+	r.GameLosses += opponentScore
+	r.LastPlayed = s.match.TimeOfSeries
+
+	r.History = append(r.History, Change{
+		Time:              s.match.TimeOfSeries,
+		TournamentID:      s.tournament.ID,
+		TournamentName:    s.tournament.Name,
+		MatchUUID:         s.match.UUID,
+		Stage:             s.match.Name,
+		Opponent:          opponent.Team,
+		OpponentRating:    opponentBefore.Rating,
+		Score:             score,
+		OpponentScore:     opponentScore,
+		Won:               won,
+		Before:            before.Rating,
+		After:             r.Rating,
+		Deviation:         before.Deviation,
+		OpponentDeviation: opponentBefore.Deviation,
+	})
+}
+
+// Export is a snapshot of the ratings of all teams and the options they were computed with
+type Export struct {
+	Options Options
+	// AsOf is the time of the last series that was rated
+	AsOf    time.Time
+	Ratings []Rating
+}
+
+// NewExport returns the export of ratings computed with opts
+func NewExport(ratings []Rating, opts Options) Export {
+	export := Export{Options: opts, Ratings: ratings}
+	for _, r := range ratings {
+		if r.LastPlayed.After(export.AsOf) {
+			export.AsOf = r.LastPlayed
+		}
+	}
+	return export
+}
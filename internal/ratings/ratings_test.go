@@ -0,0 +1,207 @@
+package ratings
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func team(id string) domain.MatchTeam {
+	return domain.MatchTeam{UUID: id, Name: "Team " + id, Shorthand: id}
+}
+
+func completed(id, a, b string, scoreA, scoreB int, day int) domain.Match {
+	return domain.Match{
+		UUID:         id,
+		Name:         id,
+		TeamA:        team(a),
+		TeamB:        team(b),
+		TeamAScore:   scoreA,
+		TeamBScore:   scoreB,
+		IsCompleted:  true,
+		TimeOfSeries: time.Date(2026, 3, day, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestReplay_Elo(t *testing.T) {
+	eu := domain.Tournament{ID: "eu", Name: "EU Open", Region: domain.RegionEU}
+	major := domain.Tournament{ID: "major", Name: "Major"}
+
+	tournaments := []domain.TournamentMatches{
+		// The major is listed first but played later
+		{Tournament: major, Matches: []domain.Match{
+			completed("m1", "B", "A", 4, 2, 10),
+			{UUID: "m2", TeamA: team("A"), TeamB: domain.MatchTeam{Name: "TBD"}},
+			{UUID: "m3", TeamA: team("A"), TeamB: team("B"), TimeOfSeries: time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC)},
+		}},
+		{Tournament: eu, Matches: []domain.Match{
+			completed("e1", "A", "B", 3, 1, 1),
+			completed("e2", "A", "C", 3, 0, 2),
+		}},
+	}
+	// A best-of-seven between games is not rated yet
+	live := completed("m4", "C", "B", 2, 1, 12)
+	live.Type = "BO7"
+	tournaments[0].Matches = append(tournaments[0].Matches, live)
+
+	records := Replay(tournaments, DefaultOptions())
+	require.Len(t, records, 3)
+
+	a, ok := findByUUID(records, "A")
+	require.True(t, ok)
+	require.Len(t, a.History, 3)
+	assert.Equal(t, []string{"e1", "e2", "m1"}, []string{a.History[0].MatchUUID, a.History[1].MatchUUID, a.History[2].MatchUUID})
+
+	// A beats B: +16, A beats C (1500): 1516 vs 1500, then loses to B (1484)
+	assert.InDelta(t, 1516, a.History[0].After, 1e-9)
+	assert.InDelta(t, 1500, a.History[1].OpponentRating, 1e-9)
+	assert.Equal(t, a.History[1].After, a.History[2].Before)
+	assert.False(t, a.History[2].Won)
+	assert.Equal(t, 2, a.History[2].Score)
+	assert.Equal(t, 4, a.History[2].OpponentScore)
+	assert.Equal(t, a.History[2].After, a.Rating)
+
+	assert.Equal(t, 2, a.SeriesWins)
+	assert.Equal(t, 1, a.SeriesLosses)
+	assert.Equal(t, 8, a.GameWins)
+	assert.Equal(t, 5, a.GameLosses)
+	assert.Equal(t, time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC), a.LastPlayed)
+	assert.Equal(t, domain.RegionEU, a.Region)
+	assert.Equal(t, []domain.Region{domain.RegionEU}, a.Regions)
+	assert.True(t, a.HasRegion("eu"))
+	assert.Zero(t, a.Deviation)
+
+	c, ok := findByUUID(records, "C")
+	require.True(t, ok)
+	require.Len(t, c.History, 1)
+
+	// Ratings are sorted and ranked, the total is preserved by Elo
+	total := 0.0
+	for i, r := range records {
+		assert.Equal(t, i+1, r.Rank)
+		if i > 0 {
+			assert.GreaterOrEqual(t, records[i-1].Rating, r.Rating)
+		}
+		total += r.Rating
+	}
+	assert.InDelta(t, 3*DefaultInitial, total, 1e-9)
+}
+
+func TestReplay_ByGame(t *testing.T) {
+	withMaps := completed("s1", "A", "B", 3, 1, 1)
+	withMaps.Maps = []domain.MatchMap{
+		{TeamAScore: 2, TeamBScore: 1},
+		{TeamAScore: 0, TeamBScore: 1},
+		{TeamAScore: 3, TeamBScore: 0},
+		{TeamAScore: 1, TeamBScore: 0},
+		{},
+	}
+	tournaments := []domain.TournamentMatches{{Matches: []domain.Match{withMaps}}}
+
+	opts := DefaultOptions()
+	opts.ByGame = true
+	byGame := Replay(tournaments, opts)
+	bySeries := Replay(tournaments, DefaultOptions())
+
+	a, _ := findByUUID(byGame, "A")
+	aSeries, _ := findByUUID(bySeries, "A")
+	// Three game wins and a loss move the rating further than a single series win
+	assert.Greater(t, a.Rating, aSeries.Rating)
+	require.Len(t, a.History, 1)
+
+	// Without game scores the series score is used
+	withMaps.Maps = nil
+	fallback := Replay([]domain.TournamentMatches{{Matches: []domain.Match{withMaps}}}, opts)
+	aFallback, _ := findByUUID(fallback, "A")
+	assert.Greater(t, aFallback.Rating, aSeries.Rating)
+}
+
+func TestReplay_Glicko2AndSeeds(t *testing.T) {
+	tournaments := []domain.TournamentMatches{
+		{Tournament: domain.Tournament{Region: domain.RegionEU}, Matches: []domain.Match{completed("e1", "A", "B", 3, 0, 1)}},
+		{Tournament: domain.Tournament{Region: domain.RegionOCE}, Matches: []domain.Match{completed("o1", "C", "D", 3, 0, 1)}},
+	}
+
+	opts := DefaultOptions()
+	opts.System = SystemGlicko2
+	opts.Seeds = map[domain.Region]float64{domain.RegionEU: 1600}
+	records := Replay(tournaments, opts)
+	require.Len(t, records, 4)
+
+	a, _ := findByUUID(records, "A")
+	c, _ := findByUUID(records, "C")
+	assert.Equal(t, 1600.0, a.History[0].Before)
+	assert.Equal(t, 1500.0, c.History[0].Before)
+	assert.Greater(t, a.Rating, 1600.0)
+	assert.Less(t, a.Deviation, float64(glickoInitialDeviation))
+	assert.NotZero(t, a.Volatility)
+}
+
+func TestFind(t *testing.T) {
+	records := []Rating{
+		{TeamUUID: "vit", Team: "Team Vitality", Shorthand: "VIT"},
+		{TeamUUID: "kc", Team: "Karmine Corp", Shorthand: "KC"},
+		{TeamUUID: "fal", Team: "Team Falcons", Shorthand: "FLCN"},
+	}
+
+	assert.Len(t, Find(records, "kc"), 1)
+	assert.Len(t, Find(records, "vit"), 1)
+	assert.Len(t, Find(records, "team"), 2)
+	assert.Empty(t, Find(records, "g2"))
+}
+
+func TestOptions_Validate(t *testing.T) {
+	assert.NoError(t, DefaultOptions().Validate())
+
+	opts := DefaultOptions()
+	opts.System = "trueskill"
+	assert.EqualError(t, opts.Validate(), `unknown rating system "trueskill", must be one of: elo, glicko2`)
+
+	opts = DefaultOptions()
+	opts.KFactor = 0
+	assert.EqualError(t, opts.Validate(), "k-factor must be positive")
+
+	opts = DefaultOptions()
+	opts.Seeds = map[domain.Region]float64{domain.RegionNA: -1}
+	assert.EqualError(t, opts.Validate(), "seed rating of region NA must be positive")
+}
+
+func TestNewExport(t *testing.T) {
+	latest := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	records := []Rating{{TeamUUID: "a", LastPlayed: latest.Add(-time.Hour)}, {TeamUUID: "b", LastPlayed: latest}}
+
+	export := NewExport(records, DefaultOptions())
+	assert.Equal(t, latest, export.AsOf)
+	assert.Equal(t, SystemElo, export.Options.System)
+	assert.Len(t, export.Ratings, 2)
+}
+
+func findByUUID(records []Rating, id string) (Rating, bool) {
+	for _, r := range records {
+		if r.TeamUUID == id {
+			return r, true
+		}
+	}
+	return Rating{}, false
+}
+
+func TestExpected(t *testing.T) {
+	strong := Rating{Rating: 1700}
+	weak := Rating{Rating: 1500}
+	assert.InDelta(t, 0.76, Expected(strong, weak, SystemElo), 0.01)
+	assert.InDelta(t, 1, Expected(strong, weak, SystemElo)+Expected(weak, strong, SystemElo), 1e-9)
+
+	// Uncertain Glicko-2 ratings are closer to a coin flip
+	certain := Expected(Rating{Rating: 1700, Deviation: 50}, Rating{Rating: 1500, Deviation: 50}, SystemGlicko2)
+	uncertain := Expected(Rating{Rating: 1700, Deviation: 300}, Rating{Rating: 1500, Deviation: 300}, SystemGlicko2)
+	assert.Greater(t, certain, uncertain)
+	assert.Greater(t, uncertain, 0.5)
+
+	opts := DefaultOptions()
+	opts.System = SystemGlicko2
+	assert.Equal(t, Rating{Rating: DefaultInitial, Deviation: glickoInitialDeviation, Volatility: glickoInitialVolatility}, opts.Unrated())
+	assert.Equal(t, Rating{Rating: DefaultInitial}, DefaultOptions().Unrated())
+}
@@ -0,0 +1,107 @@
+package ratings
+
+import "math"
+
+const (
+	// glickoScale converts between Glicko and Glicko-2 rating scales
+	glickoScale = 173.7178
+	// glickoInitialDeviation is the rating deviation of teams that have not played yet
+	glickoInitialDeviation = 350
+	// glickoInitialVolatility is the volatility of teams that have not played yet
+	glickoInitialVolatility = 0.06
+	// glickoTau constrains the change of the volatility over time
+	glickoTau = 0.5
+	// glickoEpsilon is the convergence tolerance of the volatility iteration
+	glickoEpsilon = 0.000001
+)
+
+// eloExpected returns the expected score of a team rated a against a team rated b
+func eloExpected(a, b float64) float64 {
+	return 1 / (1 + math.Pow(10, (b-a)/400))
+}
+
+// eloUpdate returns the new rating of a team rated a after scoring score (1 for a win, 0 for a loss)
+// against a team rated b
+func eloUpdate(a, b, score, k float64) float64 {
+	return a + k*(score-eloExpected(a, b))
+}
+
+// glicko is a Glicko-2 rating on the internal Glicko-2 scale
+type glicko struct {
+	mu    float64
+	phi   float64
+	sigma float64
+}
+
+func toGlicko(rating, deviation, volatility float64) glicko {
+	return glicko{
+		mu:    (rating - DefaultInitial) / glickoScale,
+		phi:   deviation / glickoScale,
+		sigma: volatility,
+	}
+}
+
+func (g glicko) rating() float64 {
+	return g.mu*glickoScale + DefaultInitial
+}
+
+func (g glicko) deviation() float64 {
+	return g.phi * glickoScale
+}
+
+func glickoG(phi float64) float64 {
+	return 1 / math.Sqrt(1+3*phi*phi/(math.Pi*math.Pi))
+}
+
+// glickoExpected returns the expected score of p against o
+func glickoExpected(p, o glicko) float64 {
+	return 1 / (1 + math.Exp(-glickoG(o.phi)*(p.mu-o.mu)))
+}
+
+// glickoUpdate returns the rating of p after a rating period with a single result against o,
+// following Glickman's "Example of the Glicko-2 system"
+func glickoUpdate(p, o glicko, score float64) glicko {
+	g := glickoG(o.phi)
+	e := glickoExpected(p, o)
+	v := 1 / (g * g * e * (1 - e))
+	delta := v * g * (score - e)
+
+	// Find the new volatility with the Illinois algorithm
+	a := math.Log(p.sigma * p.sigma)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		d := p.phi*p.phi + v + ex
+		return ex*(delta*delta-p.phi*p.phi-v-ex)/(2*d*d) - (x-a)/(glickoTau*glickoTau)
+	}
+
+	lower := a
+	var upper float64
+	if delta*delta > p.phi*p.phi+v {
+		upper = math.Log(delta*delta - p.phi*p.phi - v)
+	} else {
+		k := 1.0
+		for f(a-k*glickoTau) < 0 {
+			k++
+		}
+		upper = a - k*glickoTau
+	}
+
+	fLower, fUpper := f(lower), f(upper)
+	for math.Abs(upper-lower) > glickoEpsilon {
+		c := lower + (lower-upper)*fLower/(fUpper-fLower)
+		fC := f(c)
+		if fC*fUpper <= 0 {
+			lower, fLower = upper, fUpper
+		} else {
+			fLower /= 2
+		}
+		upper, fUpper = c, fC
+	}
+	sigma := math.Exp(lower / 2)
+
+	phiStar := math.Sqrt(p.phi*p.phi + sigma*sigma)
+	phi := 1 / math.Sqrt(1/(phiStar*phiStar)+1/v)
+	mu := p.mu + phi*phi*g*(score-e)
+
+	return glicko{mu: mu, phi: phi, sigma: sigma}
+}
@@ -0,0 +1,43 @@
+package ratings
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestElo(t *testing.T) {
+	assert.InDelta(t, 0.5, eloExpected(1500, 1500), 1e-9)
+	assert.InDelta(t, 0.76, eloExpected(1700, 1500), 0.01)
+	assert.InDelta(t, 1, eloExpected(1700, 1500)+eloExpected(1500, 1700), 1e-9)
+
+	assert.InDelta(t, 1516, eloUpdate(1500, 1500, 1, 32), 1e-9)
+	assert.InDelta(t, 1484, eloUpdate(1500, 1500, 0, 32), 1e-9)
+	// Beating a much weaker team is worth little
+	assert.Less(t, eloUpdate(1800, 1400, 1, 32)-1800, 3.0)
+}
+
+func TestGlickoUpdate(t *testing.T) {
+	p := toGlicko(1500, 200, 0.06)
+	o := toGlicko(1400, 30, 0.06)
+
+	// Glickman's example: the expected score of a 1500 (RD 200) player against a 1400 (RD 30) player
+	assert.InDelta(t, 0.639, glickoExpected(p, o), 0.001)
+
+	won := glickoUpdate(p, o, 1)
+	assert.Greater(t, won.rating(), 1500.0)
+	assert.Less(t, won.deviation(), 200.0)
+	assert.InDelta(t, 0.06, won.sigma, 0.001)
+
+	lost := glickoUpdate(p, o, 0)
+	assert.Less(t, lost.rating(), 1500.0)
+	assert.Less(t, lost.deviation(), 200.0)
+
+	// An upset moves the rating further than an expected win
+	assert.Greater(t, 1500-lost.rating(), won.rating()-1500)
+	assert.False(t, math.IsNaN(won.mu) || math.IsNaN(lost.mu))
+
+	assert.InDelta(t, 1500, toGlicko(1500, 350, 0.06).rating(), 1e-9)
+	assert.InDelta(t, 350, toGlicko(1500, 350, 0.06).deviation(), 1e-9)
+}
@@ -0,0 +1,223 @@
+// Package schema compares raw JSON responses against the Go models they are decoded into
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// IssueKind categorizes a schema issue
+type IssueKind string
+
+const (
+	// UnknownField is a field in the response that the model does not know about
+	UnknownField IssueKind = "unknown-field"
+	// MissingField is a field of the model that the response does not contain
+	MissingField IssueKind = "missing-field"
+	// TypeMismatch is a value whose JSON type does not fit the model
+	TypeMismatch IssueKind = "type-mismatch"
+	// InvalidValue is a value that decodes but cannot be mapped (e.g., unparseable timestamps)
+	InvalidValue IssueKind = "invalid-value"
+	// RequestFailed is an endpoint that could not be fetched or parsed at all
+	RequestFailed IssueKind = "request-failed"
+)
+
+// Issue describes a difference between a response and its model.
+// Array elements are collapsed into "[]" so each issue is reported once with a count.
+type Issue struct {
+	Endpoint string    `json:"endpoint" yaml:"endpoint"`
+	Path     string    `json:"path" yaml:"path"`
+	Kind     IssueKind `json:"kind" yaml:"kind"`
+	Detail   string    `json:"detail" yaml:"detail"`
+	Count    int       `json:"count" yaml:"count"`
+}
+
+// Check decodes data and reports all differences to the type of model
+func Check(endpoint string, data []byte, model interface{}) ([]Issue, error) {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+
+	c := &checker{endpoint: endpoint}
+	c.check("$", raw, reflect.TypeOf(model))
+
+	issues := c.collector.Issues()
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].Path != issues[j].Path {
+			return issues[i].Path < issues[j].Path
+		}
+		return issues[i].Kind < issues[j].Kind
+	})
+	return issues, nil
+}
+
+// Collector aggregates issues reported for the same endpoint, path and kind
+type Collector struct {
+	issues map[string]*Issue
+	order  []string
+}
+
+// Add adds an issue, increasing the count of an existing identical issue
+func (c *Collector) Add(issue Issue) {
+	if c.issues == nil {
+		c.issues = make(map[string]*Issue)
+	}
+	if issue.Count == 0 {
+		issue.Count = 1
+	}
+	key := issue.Endpoint + "\x00" + issue.Path + "\x00" + string(issue.Kind) + "\x00" + issue.Detail
+	if existing, ok := c.issues[key]; ok {
+		existing.Count += issue.Count
+		return
+	}
+	c.issues[key] = &issue
+	c.order = append(c.order, key)
+}
+
+// Issues returns all collected issues in the order they were first reported
+func (c *Collector) Issues() []Issue {
+	result := make([]Issue, 0, len(c.order))
+	for _, key := range c.order {
+		result = append(result, *c.issues[key])
+	}
+	return result
+}
+
+type checker struct {
+	endpoint  string
+	collector Collector
+}
+
+func (c *checker) add(path string, kind IssueKind, detail string) {
+	c.collector.Add(Issue{Endpoint: c.endpoint, Path: path, Kind: kind, Detail: detail})
+}
+
+func (c *checker) check(path string, value interface{}, typ reflect.Type) {
+	// null decodes into every type as a no-op
+	if value == nil {
+		return
+	}
+
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	switch typ.Kind() {
+	case reflect.Interface:
+		return
+	case reflect.String:
+		if _, ok := value.(string); !ok {
+			c.mismatch(path, value, "string")
+		}
+	case reflect.Bool:
+		if _, ok := value.(bool); !ok {
+			c.mismatch(path, value, "boolean")
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, ok := value.(float64)
+		if !ok {
+			c.mismatch(path, value, "integer")
+		} else if n != float64(int64(n)) {
+			c.add(path, TypeMismatch, fmt.Sprintf("expected integer, got %v", n))
+		}
+	case reflect.Float32, reflect.Float64:
+		if _, ok := value.(float64); !ok {
+			c.mismatch(path, value, "number")
+		}
+	case reflect.Slice, reflect.Array:
+		items, ok := value.([]interface{})
+		if !ok {
+			c.mismatch(path, value, "array")
+			return
+		}
+		for _, item := range items {
+			c.check(path+"[]", item, typ.Elem())
+		}
+	case reflect.Map:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			c.mismatch(path, value, "object")
+			return
+		}
+		for key, item := range object {
+			c.check(path+"."+key, item, typ.Elem())
+		}
+	case reflect.Struct:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			c.mismatch(path, value, "object")
+			return
+		}
+		c.checkStruct(path, object, typ)
+	}
+}
+
+func (c *checker) checkStruct(path string, object map[string]interface{}, typ reflect.Type) {
+	known := make(map[string]bool)
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, omitEmpty := jsonName(field)
+		if name == "-" {
+			continue
+		}
+		known[name] = true
+
+		value, ok := object[name]
+		if !ok {
+			if !omitEmpty {
+				c.add(path+"."+name, MissingField, "field is not present in the response")
+			}
+			continue
+		}
+		c.check(path+"."+name, value, field.Type)
+	}
+
+	for key, value := range object {
+		if !known[key] {
+			c.add(path+"."+key, UnknownField, fmt.Sprintf("field is not in the model (%s)", jsonType(value)))
+		}
+	}
+}
+
+func (c *checker) mismatch(path string, value interface{}, expected string) {
+	c.add(path, TypeMismatch, fmt.Sprintf("expected %s, got %s", expected, jsonType(value)))
+}
+
+func jsonName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "" {
+		return field.Name, false
+	}
+	name, options, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = field.Name
+	}
+	return name, strings.Contains(options, "omitempty")
+}
+
+func jsonType(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testTeam struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type testMatch struct {
+	ID       string                 `json:"id"`
+	Score    int                    `json:"score"`
+	IsLive   bool                   `json:"isLive"`
+	Teams    []testTeam             `json:"teams"`
+	Winner   *testTeam              `json:"winner"`
+	Metadata interface{}            `json:"metadata"`
+	Extra    map[string]interface{} `json:"extra,omitempty"`
+	internal string
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected []Issue
+	}{
+		{
+			name:     "matching response",
+			data:     `[{"id":"m1","score":3,"isLive":false,"teams":[{"id":"a","name":"A"}],"winner":null,"metadata":{"x":1}}]`,
+			expected: []Issue{},
+		},
+		{
+			name: "unknown and missing fields",
+			data: `[{"id":"m1","score":3,"isLive":false,"teams":[{"id":"a","logo":"x"},{"id":"b","logo":"y"}],"winner":null,"metadata":null,"venue":"Paris"}]`,
+			expected: []Issue{
+				{Endpoint: "test", Path: "$[].teams[].logo", Kind: UnknownField, Detail: "field is not in the model (string)", Count: 2},
+				{Endpoint: "test", Path: "$[].teams[].name", Kind: MissingField, Detail: "field is not present in the response", Count: 2},
+				{Endpoint: "test", Path: "$[].venue", Kind: UnknownField, Detail: "field is not in the model (string)", Count: 1},
+			},
+		},
+		{
+			name: "type mismatches",
+			data: `[{"id":1,"score":"3","isLive":"yes","teams":{},"winner":{"id":"a","name":"A"},"metadata":[]},{"id":"m2","score":2.5,"isLive":true,"teams":[],"winner":null,"metadata":null}]`,
+			expected: []Issue{
+				{Endpoint: "test", Path: "$[].id", Kind: TypeMismatch, Detail: "expected string, got number", Count: 1},
+				{Endpoint: "test", Path: "$[].isLive", Kind: TypeMismatch, Detail: "expected boolean, got string", Count: 1},
+				{Endpoint: "test", Path: "$[].score", Kind: TypeMismatch, Detail: "expected integer, got string", Count: 1},
+				{Endpoint: "test", Path: "$[].score", Kind: TypeMismatch, Detail: "expected integer, got 2.5", Count: 1},
+				{Endpoint: "test", Path: "$[].teams", Kind: TypeMismatch, Detail: "expected array, got object", Count: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues, err := Check("test", []byte(tt.data), []testMatch{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, issues)
+		})
+	}
+}
+
+func TestCheck_InvalidJSON(t *testing.T) {
+	_, err := Check("test", []byte("not json"), []testMatch{})
+	assert.ErrorContains(t, err, "failed to parse JSON")
+}
+
+func TestCollector(t *testing.T) {
+	var collector Collector
+	collector.Add(Issue{Endpoint: "a", Path: "$.x", Kind: MissingField})
+	collector.Add(Issue{Endpoint: "b", Path: "$.y", Kind: UnknownField, Count: 2})
+	collector.Add(Issue{Endpoint: "a", Path: "$.x", Kind: MissingField})
+
+	issues := collector.Issues()
+	require.Len(t, issues, 2)
+	assert.Equal(t, "$.x", issues[0].Path)
+	assert.Equal(t, 2, issues[0].Count)
+	assert.Equal(t, 2, issues[1].Count)
+}
@@ -0,0 +1,251 @@
+package simulate
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/ratings"
+	"gopkg.in/yaml.v3"
+)
+
+// Model predicts the outcome of a series between two teams identified by UUID
+type Model interface {
+	// Probability returns the probability that team a beats team b
+	Probability(a, b string) float64
+	// PerGame is true if Probability is the probability of winning a single game instead of a series
+	PerGame() bool
+}
+
+// RatingsModel predicts outcomes from team ratings
+type RatingsModel struct {
+	ratings map[string]ratings.Rating
+	opts    ratings.Options
+}
+
+// NewRatingsModel returns a model for ratings computed with opts.
+// Teams without a rating are treated as unrated teams.
+func NewRatingsModel(records []ratings.Rating, opts ratings.Options) *RatingsModel {
+	m := &RatingsModel{ratings: make(map[string]ratings.Rating, len(records)), opts: opts}
+	for _, r := range records {
+		m.ratings[r.TeamUUID] = r
+	}
+	return m
+}
+
+func (m *RatingsModel) Probability(a, b string) float64 {
+	return ratings.Expected(m.rating(a), m.rating(b), m.opts.System)
+}
+
+func (m *RatingsModel) PerGame() bool {
+	return m.opts.ByGame
+}
+
+func (m *RatingsModel) rating(team string) ratings.Rating {
+	if r, ok := m.ratings[team]; ok {
+		return r
+	}
+	return m.opts.Unrated()
+}
+
+// Odds is a user-supplied odds file. Teams are identified by UUID, name or shorthand.
+type Odds struct {
+	// Ratings holds Elo-scale team ratings, teams that are not listed are rated 1500
+	Ratings map[string]float64 `yaml:"ratings"`
+	// Series holds series win probabilities keyed by "<team> vs <team>" from the point of view
+	// of the first team, they take precedence over ratings
+	Series map[string]float64 `yaml:"series"`
+}
+
+// LoadOdds reads an odds file in YAML (or JSON) format
+func LoadOdds(path string) (*Odds, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read odds file: %w", err)
+	}
+
+	var odds Odds
+	if err := yaml.Unmarshal(data, &odds); err != nil {
+		return nil, fmt.Errorf("failed to parse odds file %s: %w", path, err)
+	}
+	return &odds, nil
+}
+
+// OddsModel predicts series outcomes from an odds file
+type OddsModel struct {
+	ratings map[string]float64
+	series  map[[2]string]float64
+}
+
+// NewOddsModel resolves the teams of the odds file against the participants of the brackets
+func NewOddsModel(odds *Odds, brackets []domain.Bracket) (*OddsModel, error) {
+	participants := make(map[string]domain.MatchTeam)
+	for _, bracket := range brackets {
+		for _, match := range bracket.Matches {
+			for _, team := range []domain.MatchTeam{match.TeamA, match.TeamB} {
+				if team.UUID != "" {
+					participants[team.UUID] = team
+				}
+			}
+		}
+	}
+
+	resolve := func(query string) (string, error) {
+		query = strings.TrimSpace(query)
+		if _, ok := participants[query]; ok {
+			return query, nil
+		}
+		var found []domain.MatchTeam
+		for _, team := range participants {
+			if strings.EqualFold(team.Name, query) || strings.EqualFold(team.Shorthand, query) {
+				found = append(found, team)
+			}
+		}
+		switch len(found) {
+		case 0:
+			return "", fmt.Errorf("unknown team %q in odds file", query)
+		case 1:
+			return found[0].UUID, nil
+		}
+
+		candidates := make([]string, 0, len(found))
+		for _, team := range found {
+			candidates = append(candidates, fmt.Sprintf("%s (%s)", team.Name, team.UUID))
+		}
+		sort.Strings(candidates)
+		return "", fmt.Errorf("team %q in odds file is ambiguous, matches: %s", query, strings.Join(candidates, ", "))
+	}
+
+	// Entries are read in a fixed order, so that the entry reported for a duplicate team does not change between runs
+	m := &OddsModel{ratings: make(map[string]float64), series: make(map[[2]string]float64)}
+	rated := make(map[string]string)
+	for _, query := range sortedKeys(odds.Ratings) {
+		id, err := resolve(query)
+		if err != nil {
+			return nil, err
+		}
+		if previous, ok := rated[id]; ok {
+			return nil, fmt.Errorf("teams %q and %q in odds file are the same team", previous, query)
+		}
+		rated[id] = query
+		m.ratings[id] = odds.Ratings[query]
+	}
+	matchups := make(map[[2]string]string)
+	for _, matchup := range sortedKeys(odds.Series) {
+		probability := odds.Series[matchup]
+		teamA, teamB, ok := strings.Cut(matchup, " vs ")
+		if !ok {
+			return nil, fmt.Errorf("invalid matchup %q in odds file, expected \"<team> vs <team>\"", matchup)
+		}
+		if probability < 0 || probability > 1 {
+			return nil, fmt.Errorf("probability of %q must be between 0 and 1", matchup)
+		}
+		a, err := resolve(teamA)
+		if err != nil {
+			return nil, err
+		}
+		b, err := resolve(teamB)
+		if err != nil {
+			return nil, err
+		}
+		if previous, ok := matchups[[2]string{a, b}]; ok {
+			return nil, fmt.Errorf("matchups %q and %q in odds file are the same series", previous, matchup)
+		}
+		matchups[[2]string{a, b}] = matchup
+		matchups[[2]string{b, a}] = matchup
+		m.series[[2]string{a, b}] = probability
+		m.series[[2]string{b, a}] = 1 - probability
+	}
+	return m, nil
+}
+
+// sortedKeys returns the keys of an odds file section in ascending order
+func sortedKeys(values map[string]float64) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (m *OddsModel) Probability(a, b string) float64 {
+	if probability, ok := m.series[[2]string{a, b}]; ok {
+		return probability
+	}
+	return 1 / (1 + math.Pow(10, (m.rating(b)-m.rating(a))/400))
+}
+
+func (m *OddsModel) PerGame() bool {
+	return false
+}
+
+func (m *OddsModel) rating(team string) float64 {
+	if rating, ok := m.ratings[team]; ok {
+		return rating
+	}
+	return ratings.DefaultInitial
+}
+
+// seriesProbability returns the probability that team a wins a best-of series against team b
+// that stands at winsA-winsB. Series of unknown length are decided by the model alone.
+func seriesProbability(model Model, a, b string, bestOf, winsA, winsB int) float64 {
+	p := model.Probability(a, b)
+	if bestOf <= 0 {
+		return p
+	}
+	if !model.PerGame() {
+		if winsA == 0 && winsB == 0 {
+			return p
+		}
+		p = gameProbability(p, bestOf)
+	}
+
+	need := bestOf/2 + 1
+	return winFrom(p, need-winsA, need-winsB)
+}
+
+// winFrom returns the probability of winning x games before the opponent wins y,
+// each game is won with probability p
+func winFrom(p float64, x, y int) float64 {
+	if x <= 0 {
+		return 1
+	}
+	if y <= 0 {
+		return 0
+	}
+
+	// Sum over the number of games k the opponent wins before the last game
+	total := 0.0
+	for k := 0; k < y; k++ {
+		total += binomial(x-1+k, k) * math.Pow(p, float64(x)) * math.Pow(1-p, float64(k))
+	}
+	return total
+}
+
+// gameProbability returns the probability of winning a single game that results in winning
+// a best-of series with probability series
+func gameProbability(series float64, bestOf int) float64 {
+	need := bestOf/2 + 1
+	low, high := 0.0, 1.0
+	for i := 0; i < 50; i++ {
+		mid := (low + high) / 2
+		if winFrom(mid, need, need) < series {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return (low + high) / 2
+}
+
+func binomial(n, k int) float64 {
+	result := 1.0
+	for i := 1; i <= k; i++ {
+		result *= float64(n-k+i) / float64(i)
+	}
+	return result
+}
@@ -0,0 +1,134 @@
+package simulate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/ratings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fixedModel gives team a the same probability against everyone
+type fixedModel struct {
+	probability map[string]float64
+	perGame     bool
+}
+
+func (m fixedModel) Probability(a, b string) float64 {
+	pa, pb := m.probability[a], m.probability[b]
+	if pa+pb == 0 {
+		return 0.5
+	}
+	return pa / (pa + pb)
+}
+
+func (m fixedModel) PerGame() bool {
+	return m.perGame
+}
+
+func TestWinFrom(t *testing.T) {
+	assert.InDelta(t, 0.5, winFrom(0.5, 4, 4), 1e-9)
+	assert.Equal(t, 1.0, winFrom(0.3, 0, 2))
+	assert.Equal(t, 0.0, winFrom(0.9, 2, 0))
+	// Winning two games before losing one: p^2
+	assert.InDelta(t, 0.36, winFrom(0.6, 2, 1), 1e-9)
+	// Best of 3 at 0-0: p^2 + 2p^2(1-p)
+	assert.InDelta(t, 0.6*0.6+2*0.6*0.6*0.4, winFrom(0.6, 2, 2), 1e-9)
+}
+
+func TestGameProbability(t *testing.T) {
+	for _, bestOf := range []int{3, 5, 7} {
+		game := gameProbability(0.8, bestOf)
+		assert.Less(t, game, 0.8)
+		assert.Greater(t, game, 0.5)
+		assert.InDelta(t, 0.8, winFrom(game, bestOf/2+1, bestOf/2+1), 1e-6)
+	}
+	assert.InDelta(t, 0.5, gameProbability(0.5, 7), 1e-6)
+}
+
+func TestSeriesProbability(t *testing.T) {
+	series := fixedModel{probability: map[string]float64{"a": 3, "b": 1}}
+	games := fixedModel{probability: map[string]float64{"a": 3, "b": 1}, perGame: true}
+
+	assert.InDelta(t, 0.75, seriesProbability(series, "a", "b", 7, 0, 0), 1e-9)
+	assert.InDelta(t, 0.75, seriesProbability(series, "a", "b", 0, 2, 0), 1e-9)
+	// Leading 3-0 in a best of 7 is worth more than the series odds
+	assert.Greater(t, seriesProbability(series, "a", "b", 7, 3, 0), 0.75)
+	assert.Less(t, seriesProbability(series, "a", "b", 7, 0, 3), 0.75)
+	assert.Equal(t, 1.0, seriesProbability(series, "a", "b", 7, 4, 2))
+
+	// Game odds of 75% win a best of 5 more often than that
+	assert.InDelta(t, winFrom(0.75, 3, 3), seriesProbability(games, "a", "b", 5, 0, 0), 1e-9)
+	assert.InDelta(t, 0.75, seriesProbability(games, "a", "b", 0, 0, 0), 1e-9)
+}
+
+func TestRatingsModel(t *testing.T) {
+	opts := ratings.DefaultOptions()
+	model := NewRatingsModel([]ratings.Rating{{TeamUUID: "a", Rating: 1700}}, opts)
+
+	assert.InDelta(t, 0.76, model.Probability("a", "unrated"), 0.01)
+	assert.InDelta(t, 0.5, model.Probability("x", "y"), 1e-9)
+	assert.False(t, model.PerGame())
+
+	opts.ByGame = true
+	assert.True(t, NewRatingsModel(nil, opts).PerGame())
+}
+
+func TestOddsModel(t *testing.T) {
+	brackets := []domain.Bracket{{Matches: []domain.Match{
+		{TeamA: domain.MatchTeam{UUID: "vit", Name: "Team Vitality", Shorthand: "VIT"}, TeamB: domain.MatchTeam{UUID: "kc", Name: "Karmine Corp", Shorthand: "KC"}},
+		{TeamA: domain.MatchTeam{UUID: "g2", Name: "G2 Esports", Shorthand: "G2"}},
+	}}}
+
+	model, err := NewOddsModel(&Odds{
+		Ratings: map[string]float64{"team vitality": 1700},
+		Series:  map[string]float64{"KC vs g2": 0.7},
+	}, brackets)
+	require.NoError(t, err)
+
+	assert.InDelta(t, 0.76, model.Probability("vit", "g2"), 0.01)
+	assert.InDelta(t, 0.7, model.Probability("kc", "g2"), 1e-9)
+	assert.InDelta(t, 0.3, model.Probability("g2", "kc"), 1e-9)
+	assert.False(t, model.PerGame())
+
+	_, err = NewOddsModel(&Odds{Ratings: map[string]float64{"FLCN": 1600}}, brackets)
+	assert.EqualError(t, err, `unknown team "FLCN" in odds file`)
+
+	_, err = NewOddsModel(&Odds{Series: map[string]float64{"VIT-KC": 0.5}}, brackets)
+	assert.EqualError(t, err, `invalid matchup "VIT-KC" in odds file, expected "<team> vs <team>"`)
+
+	_, err = NewOddsModel(&Odds{Series: map[string]float64{"VIT vs KC": 1.5}}, brackets)
+	assert.EqualError(t, err, `probability of "VIT vs KC" must be between 0 and 1`)
+
+	// Entries that resolve to the same team or series are rejected instead of one of them winning at random
+	_, err = NewOddsModel(&Odds{Ratings: map[string]float64{"VIT": 1700, "Team Vitality": 1600}}, brackets)
+	assert.EqualError(t, err, `teams "Team Vitality" and "VIT" in odds file are the same team`)
+
+	_, err = NewOddsModel(&Odds{Series: map[string]float64{"VIT vs KC": 0.6, "KC vs VIT": 0.5}}, brackets)
+	assert.EqualError(t, err, `matchups "KC vs VIT" and "VIT vs KC" in odds file are the same series`)
+
+	// A name or shorthand shared by two participants is ambiguous
+	brackets[0].Matches[1].TeamB = domain.MatchTeam{UUID: "kcx", Name: "Kansas City", Shorthand: "KC"}
+	_, err = NewOddsModel(&Odds{Ratings: map[string]float64{"KC": 1600}}, brackets)
+	assert.EqualError(t, err, `team "KC" in odds file is ambiguous, matches: Kansas City (kcx), Karmine Corp (kc)`)
+}
+
+func TestLoadOdds(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := LoadOdds(filepath.Join(dir, "missing.yaml"))
+	assert.ErrorContains(t, err, "failed to read odds file")
+
+	path := filepath.Join(dir, "odds.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("ratings:\n  VIT: 1650\nseries:\n  VIT vs KC: 0.6\n"), 0o644))
+	odds, err := LoadOdds(path)
+	require.NoError(t, err)
+	assert.Equal(t, &Odds{Ratings: map[string]float64{"VIT": 1650}, Series: map[string]float64{"VIT vs KC": 0.6}}, odds)
+
+	require.NoError(t, os.WriteFile(path, []byte("ratings: [\n"), 0o644))
+	_, err = LoadOdds(path)
+	assert.ErrorContains(t, err, "failed to parse odds file")
+}
@@ -0,0 +1,275 @@
+// Package simulate estimates the outcome of a tournament with Monte Carlo simulations of its brackets
+package simulate
+
+import (
+	"math/rand/v2"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/standings"
+)
+
+// Result holds the probabilities estimated by a simulation
+type Result struct {
+	Runs int
+	Seed uint64
+	// Rounds lists the rounds of the tournament in bracket order
+	Rounds []Round
+	// Teams are sorted by their probability to win the event, then to reach the later rounds
+	Teams []TeamOdds
+}
+
+// Round is a set of series of a bracket that share a name, e.g. the semifinals
+type Round struct {
+	Bracket string
+	Name    string
+}
+
+// TeamOdds holds the probabilities of a team
+type TeamOdds struct {
+	TeamUUID  string
+	Team      string
+	Shorthand string
+	// Reach holds the probability to play in each round, in the order of Result.Rounds
+	Reach []float64
+	// Win is the probability to win the event
+	Win float64
+}
+
+// roundNumber matches the number of a series within its round, e.g. the 1 of "Semifinal 1"
+var roundNumber = regexp.MustCompile(`\s+\d+$`)
+
+// series is the state of a series during a simulation run
+type series struct {
+	match domain.Match
+	round int
+	// bestOf is the maximum number of games of the series, zero if unknown
+	bestOf int
+	// final is true if the winner of the series wins the event
+	final bool
+}
+
+// slots holds the participants and the winner of a series in a simulation run
+type slots struct {
+	a, b   string
+	winner string
+	done   bool
+}
+
+// Simulate plays the brackets runs times. Completed series keep their result, the others are
+// decided by model and their winners and losers follow WinnerGoesTo and LoserGoesTo.
+// Live series continue from their current score. Series whose participants are never known
+// (e.g., pairings of later Swiss rounds that are not linked in the data) are not played.
+// The event is won by the winner of the final of the last bracket that is not Swiss or round robin.
+func Simulate(brackets []domain.Bracket, model Model, runs int, seed uint64) Result {
+	ordered := make([]domain.Bracket, len(brackets))
+	copy(ordered, brackets)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Index < ordered[j].Index
+	})
+
+	result := Result{Runs: runs, Seed: seed}
+	roundIndex := make(map[Round]int)
+	teams := make(map[string]domain.MatchTeam)
+	var order []string
+	var all []series
+	byUUID := make(map[string]int)
+
+	lastElimination := -1
+	for i, bracket := range ordered {
+		if !standings.Supported(bracket) {
+			lastElimination = i
+		}
+	}
+
+	for i, bracket := range ordered {
+		matches := make([]domain.Match, len(bracket.Matches))
+		copy(matches, bracket.Matches)
+		sort.SliceStable(matches, func(i, j int) bool {
+			return matches[i].Index < matches[j].Index
+		})
+
+		for _, match := range matches {
+			round := Round{Bracket: bracket.Label, Name: strings.TrimSpace(match.Name)}
+			// Series of elimination brackets are numbered within their round, e.g. "Semifinal 1",
+			// Swiss and round-robin series are named after their round, e.g. "Round 1"
+			if !standings.Supported(bracket) {
+				round.Name = roundNumber.ReplaceAllString(round.Name, "")
+			}
+			index, ok := roundIndex[round]
+			if !ok {
+				index = len(result.Rounds)
+				roundIndex[round] = index
+				result.Rounds = append(result.Rounds, round)
+			}
+
+			for _, team := range []domain.MatchTeam{match.TeamA, match.TeamB} {
+				if team.UUID == "" {
+					continue
+				}
+				if _, ok := teams[team.UUID]; !ok {
+					order = append(order, team.UUID)
+				}
+				teams[team.UUID] = team
+			}
+
+			byUUID[match.UUID] = len(all)
+			all = append(all, series{
+				match:  match,
+				round:  index,
+				bestOf: match.BestOf(),
+				final:  i == lastElimination && match.WinnerGoesTo == nil,
+			})
+		}
+	}
+
+	teamIndex := make(map[string]int, len(order))
+	for i, id := range order {
+		teamIndex[id] = i
+	}
+	reached := make([][]int, len(order))
+	for i := range reached {
+		reached[i] = make([]int, len(result.Rounds))
+	}
+	wins := make([]int, len(order))
+
+	rng := rand.New(rand.NewPCG(seed, seed))
+	state := make([]slots, len(all))
+	seen := make([]int, len(order)*len(result.Rounds))
+	for run := 1; run <= runs; run++ {
+		play(all, byUUID, state, model, rng)
+
+		for i, s := range all {
+			for _, team := range []string{state[i].a, state[i].b} {
+				t, ok := teamIndex[team]
+				if !ok {
+					continue
+				}
+				// Count a team once per round and run
+				key := t*len(result.Rounds) + s.round
+				if seen[key] != run {
+					seen[key] = run
+					reached[t][s.round]++
+				}
+			}
+			if s.final && state[i].done {
+				if t, ok := teamIndex[state[i].winner]; ok {
+					wins[t]++
+				}
+			}
+		}
+	}
+
+	for i, id := range order {
+		odds := TeamOdds{
+			TeamUUID:  id,
+			Team:      teams[id].Name,
+			Shorthand: teams[id].Shorthand,
+			Reach:     make([]float64, len(result.Rounds)),
+		}
+		if runs > 0 {
+			for round, count := range reached[i] {
+				odds.Reach[round] = float64(count) / float64(runs)
+			}
+			odds.Win = float64(wins[i]) / float64(runs)
+		}
+		result.Teams = append(result.Teams, odds)
+	}
+
+	sort.SliceStable(result.Teams, func(i, j int) bool {
+		a, b := result.Teams[i], result.Teams[j]
+		if a.Win != b.Win {
+			return a.Win > b.Win
+		}
+		for round := len(result.Rounds) - 1; round >= 0; round-- {
+			if a.Reach[round] != b.Reach[round] {
+				return a.Reach[round] > b.Reach[round]
+			}
+		}
+		return strings.ToLower(a.Team) < strings.ToLower(b.Team)
+	})
+
+	return result
+}
+
+// play runs a single simulation, state is reset from the series
+func play(all []series, byUUID map[string]int, state []slots, model Model, rng *rand.Rand) {
+	for i, s := range all {
+		state[i] = slots{a: s.match.TeamA.UUID, b: s.match.TeamB.UUID}
+	}
+
+	// place moves a team to a destination, teams already listed there by the data are left alone
+	place := func(destination *domain.BracketDestination, team string) {
+		if destination == nil || team == "" {
+			return
+		}
+		i, ok := byUUID[destination.SeriesUUID]
+		if !ok {
+			return
+		}
+		slot := &state[i]
+		if slot.a == team || slot.b == team {
+			return
+		}
+		switch {
+		case strings.EqualFold(destination.BracketPosition, "A") && slot.a == "":
+			slot.a = team
+		case strings.EqualFold(destination.BracketPosition, "B") && slot.b == "":
+			slot.b = team
+		case slot.a == "":
+			slot.a = team
+		case slot.b == "":
+			slot.b = team
+		}
+	}
+
+	decide := func(i int, winner string) {
+		slot := &state[i]
+		loser := slot.a
+		if winner == slot.a {
+			loser = slot.b
+		}
+		slot.winner, slot.done = winner, true
+		place(all[i].match.WinnerGoesTo, winner)
+		place(all[i].match.LoserGoesTo, loser)
+	}
+
+	// Completed series keep their result
+	for i, s := range all {
+		match := s.match
+		if !match.IsCompleted || match.TeamAScore == match.TeamBScore || state[i].a == "" || state[i].b == "" {
+			continue
+		}
+		winner := state[i].a
+		if match.TeamBScore > match.TeamAScore {
+			winner = state[i].b
+		}
+		decide(i, winner)
+	}
+
+	// Play every series whose participants are known until no more can be played
+	for progress := true; progress; {
+		progress = false
+		for i, s := range all {
+			slot := state[i]
+			if slot.done || slot.a == "" || slot.b == "" {
+				continue
+			}
+
+			winsA, winsB := 0, 0
+			// Only live series that still have their original participants continue from their score
+			if s.match.IsLive && slot.a == s.match.TeamA.UUID && slot.b == s.match.TeamB.UUID {
+				winsA, winsB = s.match.TeamAScore, s.match.TeamBScore
+			}
+
+			winner := slot.b
+			if rng.Float64() < seriesProbability(model, slot.a, slot.b, s.bestOf, winsA, winsB) {
+				winner = slot.a
+			}
+			decide(i, winner)
+			progress = true
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package simulate
+
+import (
+	"testing"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func team(id string) domain.MatchTeam {
+	return domain.MatchTeam{UUID: id, Name: "Team " + id, Shorthand: id}
+}
+
+func to(seriesUUID, position string) *domain.BracketDestination {
+	return &domain.BracketDestination{SeriesUUID: seriesUUID, BracketPosition: position}
+}
+
+// playoffs returns a single elimination bracket of four teams where the first semifinal is over
+func playoffs() []domain.Bracket {
+	return []domain.Bracket{{
+		Index:  1,
+		Label:  "Playoffs",
+		Format: "single-elim-4",
+		Matches: []domain.Match{
+			{UUID: "sf1", Index: 0, Name: "Semifinal 1", Type: "BO7", TeamA: team("A"), TeamB: team("B"), TeamAScore: 1, TeamBScore: 4, IsCompleted: true, WinnerGoesTo: to("final", "A")},
+			{UUID: "sf2", Index: 1, Name: "Semifinal 2", Type: "BO7", TeamA: team("C"), TeamB: team("D"), WinnerGoesTo: to("final", "B")},
+			{UUID: "final", Index: 2, Name: "Grand Final", Type: "BO7"},
+		},
+	}}
+}
+
+func odds(result Result, id string) TeamOdds {
+	for _, t := range result.Teams {
+		if t.TeamUUID == id {
+			return t
+		}
+	}
+	return TeamOdds{}
+}
+
+func TestSimulate(t *testing.T) {
+	// C always beats D, B always beats C
+	model := fixedModel{probability: map[string]float64{"A": 1, "B": 1000, "C": 10, "D": 0}}
+	result := Simulate(playoffs(), model, 100, 1)
+
+	assert.Equal(t, 100, result.Runs)
+	assert.Equal(t, uint64(1), result.Seed)
+	assert.Equal(t, []Round{{Bracket: "Playoffs", Name: "Semifinal"}, {Bracket: "Playoffs", Name: "Grand Final"}}, result.Rounds)
+	require.Len(t, result.Teams, 4)
+
+	b := result.Teams[0]
+	assert.Equal(t, "B", b.TeamUUID)
+	assert.Equal(t, []float64{1, 1}, b.Reach)
+	assert.InDelta(t, 1, b.Win, 0.02)
+
+	assert.Equal(t, []float64{1, 1}, odds(result, "C").Reach)
+	assert.Equal(t, []float64{1, 0}, odds(result, "D").Reach)
+	// A lost its completed semifinal
+	assert.Equal(t, []float64{1, 0}, odds(result, "A").Reach)
+	assert.Zero(t, odds(result, "A").Win)
+}
+
+func TestSimulate_Reproducible(t *testing.T) {
+	model := fixedModel{probability: map[string]float64{"A": 1, "B": 1, "C": 1, "D": 1}}
+
+	first := Simulate(playoffs(), model, 1000, 42)
+	second := Simulate(playoffs(), model, 1000, 42)
+	assert.Equal(t, first, second)
+
+	// Coin flips: C and D each reach the final about half of the time
+	assert.InDelta(t, 0.5, odds(first, "C").Reach[1], 0.05)
+	assert.InDelta(t, 0.5, odds(first, "B").Win, 0.05)
+}
+
+func TestSimulate_LiveAndCrossBracket(t *testing.T) {
+	// The Swiss winner advances into the playoffs, the semifinal between C and D is live at 3-0
+	brackets := playoffs()
+	brackets[0].Matches[0] = domain.Match{UUID: "sf1", Index: 0, Name: "Semifinal 1", Type: "BO7", TeamA: team("B"), WinnerGoesTo: to("final", "A")}
+	brackets[0].Matches[1].IsLive = true
+	brackets[0].Matches[1].TeamAScore = 3
+	swiss := domain.Bracket{
+		Index:  0,
+		Label:  "Swiss",
+		Format: "swiss-4",
+		Matches: []domain.Match{
+			{UUID: "s1", Name: "Round 1", TeamA: team("A"), TeamB: team("E"), WinnerGoesTo: to("sf1", "B")},
+		},
+	}
+
+	model := fixedModel{probability: map[string]float64{"A": 1, "B": 1, "C": 1, "D": 1, "E": 1}}
+	result := Simulate(append(brackets, swiss), model, 2000, 7)
+
+	assert.Equal(t, Round{Bracket: "Swiss", Name: "Round 1"}, result.Rounds[0])
+	// Either Swiss team reaches the semifinal
+	assert.InDelta(t, 0.5, odds(result, "A").Reach[1], 0.05)
+	assert.InDelta(t, 0.5, odds(result, "E").Reach[1], 0.05)
+	// Needing one game out of four, C reaches the final far more often than D
+	assert.InDelta(t, 1-0.5*0.5*0.5*0.5, odds(result, "C").Reach[2], 0.03)
+}
@@ -0,0 +1,57 @@
+package source
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mgranderath/rlcs-cli/internal/api/blast"
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/mapper"
+)
+
+// BlastSource reads tournament data from the live Blast API
+type BlastSource struct {
+	client *blast.Client
+}
+
+// NewBlastSource creates a source backed by a Blast API client
+func NewBlastSource(client *blast.Client) *BlastSource {
+	return &BlastSource{client: client}
+}
+
+func (s *BlastSource) Tournaments(ctx context.Context, circuit string) (mapper.Result[domain.Tournament], error) {
+	apiTournaments, err := s.client.ListTournaments(ctx, circuit)
+	if err != nil {
+		return mapper.Result[domain.Tournament]{}, err
+	}
+	return mapper.ToDomainTournaments(apiTournaments), nil
+}
+
+func (s *BlastSource) TournamentMatches(ctx context.Context, tournamentID string) (mapper.Result[domain.Match], error) {
+	apiMatches, err := s.client.TournamentMatches(ctx, tournamentID)
+	if err != nil {
+		return mapper.Result[domain.Match]{}, err
+	}
+	return mapper.ToDomainMatchesFromResponse(apiMatches), nil
+}
+
+func (s *BlastSource) TournamentBrackets(ctx context.Context, tournamentID string) (mapper.Result[domain.Bracket], error) {
+	apiBrackets, err := s.client.TournamentBrackets(ctx, tournamentID)
+	if err != nil {
+		return mapper.Result[domain.Bracket]{}, err
+	}
+	return mapper.ToDomainBrackets(apiBrackets), nil
+}
+
+func (s *BlastSource) Match(ctx context.Context, matchID string) (domain.Match, error) {
+	apiMatch, err := s.client.MatchDetail(ctx, matchID)
+	if err != nil {
+		return domain.Match{}, err
+	}
+
+	match, err := mapper.ToDomainMatchFromDetailResponse(apiMatch)
+	if err != nil {
+		return domain.Match{}, fmt.Errorf("failed to map match: %w", err)
+	}
+	return match, nil
+}
@@ -0,0 +1,97 @@
+package source
+
+import (
+	"context"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/mapper"
+)
+
+// LocationSource converts the times of another source to a time zone, so that every command
+// renders them in that zone and encodes them with its offset
+type LocationSource struct {
+	src Source
+	loc *time.Location
+}
+
+// InLocation returns a source converting the times of src to loc
+func InLocation(src Source, loc *time.Location) *LocationSource {
+	return &LocationSource{src: src, loc: loc}
+}
+
+func (s *LocationSource) Tournaments(ctx context.Context, circuit string) (mapper.Result[domain.Tournament], error) {
+	result, err := s.src.Tournaments(ctx, circuit)
+	if err != nil {
+		return result, err
+	}
+	for i := range result.Items {
+		result.Items[i].StartDate = dateIn(result.Items[i].StartDate, s.loc)
+		result.Items[i].EndDate = dateIn(result.Items[i].EndDate, s.loc)
+	}
+	return result, nil
+}
+
+func (s *LocationSource) TournamentMatches(ctx context.Context, tournamentID string) (mapper.Result[domain.Match], error) {
+	result, err := s.src.TournamentMatches(ctx, tournamentID)
+	if err != nil {
+		return result, err
+	}
+	for i := range result.Items {
+		matchIn(&result.Items[i], s.loc)
+	}
+	return result, nil
+}
+
+func (s *LocationSource) TournamentBrackets(ctx context.Context, tournamentID string) (mapper.Result[domain.Bracket], error) {
+	result, err := s.src.TournamentBrackets(ctx, tournamentID)
+	if err != nil {
+		return result, err
+	}
+	for i := range result.Items {
+		bracket := &result.Items[i]
+		bracket.StartDate = dateIn(bracket.StartDate, s.loc)
+		bracket.EndDate = dateIn(bracket.EndDate, s.loc)
+		for j := range bracket.Matches {
+			matchIn(&bracket.Matches[j], s.loc)
+		}
+	}
+	return result, nil
+}
+
+func (s *LocationSource) Match(ctx context.Context, matchID string) (domain.Match, error) {
+	match, err := s.src.Match(ctx, matchID)
+	if err != nil {
+		return match, err
+	}
+	matchIn(&match, s.loc)
+	return match, nil
+}
+
+// matchIn converts the times of a match and its games to loc
+func matchIn(match *domain.Match, loc *time.Location) {
+	match.TimeOfSeries = timeIn(match.TimeOfSeries, loc)
+	for i := range match.Maps {
+		game := &match.Maps[i]
+		game.ScheduledStartTime = timeIn(game.ScheduledStartTime, loc)
+		game.ActualStartTime = timeIn(game.ActualStartTime, loc)
+		game.MatchEndedTime = timeIn(game.MatchEndedTime, loc)
+	}
+}
+
+// timeIn converts t to loc, zero times are kept
+func timeIn(t time.Time, loc *time.Location) time.Time {
+	if t.IsZero() {
+		return t
+	}
+	return t.In(loc)
+}
+
+// dateIn converts a tournament or bracket date to loc. Dates without a time of day (midnight UTC)
+// are calendar days and are kept, converting them would move them to the previous day west of UTC.
+func dateIn(t time.Time, loc *time.Location) time.Time {
+	if t.IsZero() || (t.Location() == time.UTC && t.Equal(t.Truncate(24*time.Hour))) {
+		return t
+	}
+	return t.In(loc)
+}
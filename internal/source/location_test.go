@@ -0,0 +1,57 @@
+package source
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocationSource(t *testing.T) {
+	snapshot, err := NewSnapshotSource(filepath.Join("testdata", "snapshot"))
+	require.NoError(t, err)
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+	src := InLocation(snapshot, paris)
+	ctx := context.Background()
+
+	tournaments, err := src.Tournaments(ctx, "2025")
+	require.NoError(t, err)
+	original, err := snapshot.Tournaments(ctx, "2025")
+	require.NoError(t, err)
+	// Calendar days are not moved
+	assert.Equal(t, original.Items[0].StartDate, tournaments.Items[0].StartDate)
+
+	match, err := src.Match(ctx, "m1")
+	require.NoError(t, err)
+	expected, err := snapshot.Match(ctx, "m1")
+	require.NoError(t, err)
+	assert.Equal(t, paris, match.TimeOfSeries.Location())
+	assert.True(t, expected.TimeOfSeries.Equal(match.TimeOfSeries))
+	require.NotEmpty(t, match.Maps)
+	assert.Equal(t, paris, match.Maps[0].ScheduledStartTime.Location())
+
+	matches, err := src.TournamentMatches(ctx, "t1")
+	require.NoError(t, err)
+	assert.Equal(t, paris, matches.Items[0].TimeOfSeries.Location())
+
+	brackets, err := src.TournamentBrackets(ctx, "t1")
+	require.NoError(t, err)
+	require.NotEmpty(t, brackets.Items[0].Matches)
+	assert.Equal(t, paris, brackets.Items[0].Matches[0].TimeOfSeries.Location())
+}
+
+func TestDateIn(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	date := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, date, dateIn(date, tokyo))
+
+	instant := time.Date(2026, 3, 1, 18, 0, 0, 0, time.UTC)
+	assert.Equal(t, "2026-03-02T03:00:00+09:00", dateIn(instant, tokyo).Format(time.RFC3339))
+
+	assert.True(t, dateIn(time.Time{}, tokyo).IsZero())
+}
@@ -0,0 +1,105 @@
+package source
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/mgranderath/rlcs-cli/internal/api/blast"
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/mapper"
+)
+
+// SnapshotSource reads tournament data from a directory of Blast API responses.
+// It uses the same layout as the fixtures of the dev mock server:
+//
+//	circuits/{circuit}/tournaments.json
+//	tournaments/{id}/matches.json
+//	tournaments/{id}/brackets.json
+//	matches/{id}.json
+type SnapshotSource struct {
+	dir string
+}
+
+// NewSnapshotSource creates a source reading from a snapshot directory
+func NewSnapshotSource(dir string) (*SnapshotSource, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open snapshot: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("snapshot %s is not a directory", dir)
+	}
+	return &SnapshotSource{dir: dir}, nil
+}
+
+func (s *SnapshotSource) Tournaments(ctx context.Context, circuit string) (mapper.Result[domain.Tournament], error) {
+	var apiTournaments []blast.Tournament
+	if err := s.read(&apiTournaments, "circuits", circuit, "tournaments.json"); err != nil {
+		return mapper.Result[domain.Tournament]{}, notFound(err, "circuit", circuit)
+	}
+	return mapper.ToDomainTournaments(apiTournaments), nil
+}
+
+func (s *SnapshotSource) TournamentMatches(ctx context.Context, tournamentID string) (mapper.Result[domain.Match], error) {
+	var apiMatches []blast.MatchResponse
+	if err := s.read(&apiMatches, "tournaments", tournamentID, "matches.json"); err != nil {
+		return mapper.Result[domain.Match]{}, notFound(err, "tournament", tournamentID)
+	}
+	return mapper.ToDomainMatchesFromResponse(apiMatches), nil
+}
+
+func (s *SnapshotSource) TournamentBrackets(ctx context.Context, tournamentID string) (mapper.Result[domain.Bracket], error) {
+	var apiBrackets []blast.Bracket
+	if err := s.read(&apiBrackets, "tournaments", tournamentID, "brackets.json"); err != nil {
+		return mapper.Result[domain.Bracket]{}, notFound(err, "tournament", tournamentID)
+	}
+	return mapper.ToDomainBrackets(apiBrackets), nil
+}
+
+func (s *SnapshotSource) Match(ctx context.Context, matchID string) (domain.Match, error) {
+	var apiMatch blast.MatchResponse
+	if err := s.read(&apiMatch, "matches", matchID+".json"); err != nil {
+		return domain.Match{}, notFound(err, "match", matchID)
+	}
+
+	match, err := mapper.ToDomainMatchFromDetailResponse(apiMatch)
+	if err != nil {
+		return domain.Match{}, fmt.Errorf("failed to map match: %w", err)
+	}
+	return match, nil
+}
+
+// read decodes the snapshot file at the path given by elem into v
+func (s *SnapshotSource) read(v interface{}, elem ...string) error {
+	for _, e := range elem {
+		if e == "" || e == "." || e == ".." || filepath.Base(e) != e {
+			return fs.ErrNotExist
+		}
+	}
+
+	path := filepath.Join(append([]string{s.dir}, elem...)...)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	slog.Debug("read snapshot", "path", path, "bytes", len(data))
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to parse JSON in %s: %w", path, err)
+	}
+	return nil
+}
+
+// notFound reports missing snapshot files the same way as the API reports unknown IDs
+func notFound(err error, kind, id string) error {
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("%s %w: %s", kind, blast.ErrNotFound, id)
+	}
+	return err
+}
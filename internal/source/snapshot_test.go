@@ -0,0 +1,84 @@
+package source
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/mgranderath/rlcs-cli/internal/api/blast"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotSource(t *testing.T) {
+	src, err := NewSnapshotSource(filepath.Join("testdata", "snapshot"))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	tournaments, err := src.Tournaments(ctx, "2025")
+	require.NoError(t, err)
+	require.Len(t, tournaments.Items, 1)
+	assert.Equal(t, "t1", tournaments.Items[0].ID)
+	require.Len(t, tournaments.Skipped, 1)
+	assert.Equal(t, "t2", tournaments.Skipped[0].ID)
+
+	matches, err := src.TournamentMatches(ctx, "t1")
+	require.NoError(t, err)
+	require.Len(t, matches.Items, 1)
+	assert.Equal(t, "m1", matches.Items[0].UUID)
+	assert.True(t, matches.Items[0].IsCompleted)
+	require.Len(t, matches.Skipped, 1)
+	assert.Equal(t, "m2", matches.Skipped[0].ID)
+
+	brackets, err := src.TournamentBrackets(ctx, "t1")
+	require.NoError(t, err)
+	require.Len(t, brackets.Items, 1)
+	assert.Equal(t, "Playoffs", brackets.Items[0].Label)
+	assert.Empty(t, brackets.Skipped)
+
+	match, err := src.Match(ctx, "m1")
+	require.NoError(t, err)
+	assert.Equal(t, "Grand Final", match.Name)
+	assert.Equal(t, 3, match.TeamAScore)
+}
+
+func TestSnapshotSource_NotFound(t *testing.T) {
+	src, err := NewSnapshotSource(filepath.Join("testdata", "snapshot"))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = src.Tournaments(ctx, "2019")
+	assert.ErrorIs(t, err, blast.ErrNotFound)
+	assert.EqualError(t, err, "circuit not found: 2019")
+
+	_, err = src.TournamentMatches(ctx, "missing")
+	assert.EqualError(t, err, "tournament not found: missing")
+
+	_, err = src.TournamentBrackets(ctx, "../t1")
+	assert.EqualError(t, err, "tournament not found: ../t1")
+
+	_, err = src.Match(ctx, "missing")
+	assert.EqualError(t, err, "match not found: missing")
+}
+
+func TestOpen(t *testing.T) {
+	client := blast.NewClient()
+
+	src, err := Open("", client)
+	require.NoError(t, err)
+	assert.IsType(t, &BlastSource{}, src)
+
+	src, err = Open(Blast, client)
+	require.NoError(t, err)
+	assert.IsType(t, &BlastSource{}, src)
+
+	src, err = Open(filepath.Join("testdata", "snapshot"), client)
+	require.NoError(t, err)
+	assert.IsType(t, &SnapshotSource{}, src)
+
+	_, err = Open(filepath.Join("testdata", "missing"), client)
+	assert.ErrorContains(t, err, "failed to open snapshot")
+
+	_, err = Open(filepath.Join("testdata", "snapshot", "matches", "m1.json"), client)
+	assert.ErrorContains(t, err, "is not a directory")
+}
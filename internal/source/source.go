@@ -0,0 +1,35 @@
+// Package source provides the tournament data commands operate on
+package source
+
+import (
+	"context"
+
+	"github.com/mgranderath/rlcs-cli/internal/api/blast"
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/mapper"
+)
+
+// Blast is the name of the live Blast API source
+const Blast = "blast"
+
+// Source returns tournament data as domain models.
+// List methods report records that could not be mapped in the result instead of failing.
+type Source interface {
+	// Tournaments returns all tournaments of a circuit/year
+	Tournaments(ctx context.Context, circuit string) (mapper.Result[domain.Tournament], error)
+	// TournamentMatches returns all matches of a tournament
+	TournamentMatches(ctx context.Context, tournamentID string) (mapper.Result[domain.Match], error)
+	// TournamentBrackets returns all brackets of a tournament
+	TournamentBrackets(ctx context.Context, tournamentID string) (mapper.Result[domain.Bracket], error)
+	// Match returns the details of a single match
+	Match(ctx context.Context, matchID string) (domain.Match, error)
+}
+
+// Open returns the source selected by name: "blast" (or empty) for the live API
+// using client, anything else is treated as the path of a snapshot directory
+func Open(name string, client *blast.Client) (Source, error) {
+	if name == "" || name == Blast {
+		return NewBlastSource(client), nil
+	}
+	return NewSnapshotSource(name)
+}
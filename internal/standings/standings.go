@@ -0,0 +1,228 @@
+// Package standings computes the standings of Swiss and round-robin brackets
+package standings
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+)
+
+// Tiebreaker names the criterion that separates teams with the same series record
+type Tiebreaker string
+
+const (
+	TiebreakGameDifferential Tiebreaker = "game-differential"
+	TiebreakHeadToHead       Tiebreaker = "head-to-head"
+	TiebreakBuchholz         Tiebreaker = "buchholz"
+	TiebreakGameWins         Tiebreaker = "game-wins"
+	TiebreakNone             Tiebreaker = "tied"
+)
+
+// Table holds the standings of a single bracket
+type Table struct {
+	BracketUUID string
+	Bracket     string
+	Label       string
+	Format      string
+	Standings   []Standing
+}
+
+// Standing is the position of a team in a bracket
+type Standing struct {
+	Rank             int
+	TeamUUID         string
+	Team             string
+	Shorthand        string
+	SeriesWins       int
+	SeriesLosses     int
+	GameWins         int
+	GameLosses       int
+	GameDifferential int
+	// Buchholz is the sum of the series wins of all opponents faced so far
+	Buchholz int
+	// HeadToHeadWins counts series won against teams with the same record and game differential
+	HeadToHeadWins int
+	// Remaining counts scheduled series that have not been completed yet
+	Remaining    int
+	IsEliminated bool
+	// Tiebreaker is the criterion that separated the team from a neighbour with the same series record
+	Tiebreaker Tiebreaker
+}
+
+// Supported returns true if standings can be computed for the bracket
+func Supported(bracket domain.Bracket) bool {
+	return bracket.IsSwiss() || bracket.IsRoundRobin()
+}
+
+// Compute returns the standings of all Swiss and round-robin brackets, other brackets are skipped
+func Compute(brackets []domain.Bracket) []Table {
+	tables := make([]Table, 0)
+	for _, bracket := range brackets {
+		if !Supported(bracket) {
+			continue
+		}
+		tables = append(tables, ComputeBracket(bracket))
+	}
+	return tables
+}
+
+// ComputeBracket returns the standings of a single bracket.
+// Teams are ranked by series record, then game differential, series won against the teams
+// they are still tied with, Buchholz score and games won.
+func ComputeBracket(bracket domain.Bracket) Table {
+	entries := make(map[string]*Standing)
+	order := make([]string, 0)
+	opponents := make(map[string][]string)
+	// won[a][b] counts the series a won against b
+	won := make(map[string]map[string]int)
+
+	entry := func(team domain.MatchTeam) *Standing {
+		s, ok := entries[team.UUID]
+		if !ok {
+			s = &Standing{TeamUUID: team.UUID}
+			entries[team.UUID] = s
+			order = append(order, team.UUID)
+			won[team.UUID] = make(map[string]int)
+		}
+		s.Team = team.Name
+		s.Shorthand = team.Shorthand
+		s.IsEliminated = s.IsEliminated || team.IsEliminated
+		return s
+	}
+
+	for _, match := range bracket.Matches {
+		if match.TeamA.UUID == "" || match.TeamB.UUID == "" {
+			continue
+		}
+		a, b := entry(match.TeamA), entry(match.TeamB)
+
+		if !match.IsCompleted {
+			a.Remaining++
+			b.Remaining++
+			continue
+		}
+
+		a.GameWins += match.TeamAScore
+		a.GameLosses += match.TeamBScore
+		b.GameWins += match.TeamBScore
+		b.GameLosses += match.TeamAScore
+		opponents[a.TeamUUID] = append(opponents[a.TeamUUID], b.TeamUUID)
+		opponents[b.TeamUUID] = append(opponents[b.TeamUUID], a.TeamUUID)
+
+		switch {
+		case match.TeamAScore > match.TeamBScore:
+			a.SeriesWins++
+			b.SeriesLosses++
+			won[a.TeamUUID][b.TeamUUID]++
+		case match.TeamBScore > match.TeamAScore:
+			b.SeriesWins++
+			a.SeriesLosses++
+			won[b.TeamUUID][a.TeamUUID]++
+		}
+	}
+
+	standings := make([]Standing, 0, len(order))
+	for _, id := range order {
+		s := entries[id]
+		s.GameDifferential = s.GameWins - s.GameLosses
+		for _, opponent := range opponents[id] {
+			s.Buchholz += entries[opponent].SeriesWins
+		}
+		standings = append(standings, *s)
+	}
+
+	rank(standings, won)
+
+	return Table{
+		BracketUUID: bracket.TournamentUUID,
+		Bracket:     bracket.TournamentName,
+		Label:       bracket.Label,
+		Format:      bracket.Format,
+		Standings:   standings,
+	}
+}
+
+// rank sorts the standings and assigns ranks and tiebreakers
+func rank(standings []Standing, won map[string]map[string]int) {
+	sort.SliceStable(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if !sameRecord(a, b) {
+			if a.SeriesWins != b.SeriesWins {
+				return a.SeriesWins > b.SeriesWins
+			}
+			return a.SeriesLosses < b.SeriesLosses
+		}
+		return a.GameDifferential > b.GameDifferential
+	})
+
+	// Head-to-head only counts series between the teams that are still tied
+	for start := 0; start < len(standings); {
+		end := start + 1
+		for end < len(standings) && sameRecord(standings[start], standings[end]) &&
+			standings[start].GameDifferential == standings[end].GameDifferential {
+			end++
+		}
+
+		group := standings[start:end]
+		for i := range group {
+			for j := range group {
+				group[i].HeadToHeadWins += won[group[i].TeamUUID][group[j].TeamUUID]
+			}
+		}
+		sort.SliceStable(group, func(i, j int) bool {
+			a, b := group[i], group[j]
+			if a.HeadToHeadWins != b.HeadToHeadWins {
+				return a.HeadToHeadWins > b.HeadToHeadWins
+			}
+			if a.Buchholz != b.Buchholz {
+				return a.Buchholz > b.Buchholz
+			}
+			if a.GameWins != b.GameWins {
+				return a.GameWins > b.GameWins
+			}
+			return strings.ToLower(a.Team) < strings.ToLower(b.Team)
+		})
+
+		start = end
+	}
+
+	for i := range standings {
+		standings[i].Rank = i + 1
+		if i > 0 && tiebreaker(standings[i-1], standings[i]) == TiebreakNone {
+			standings[i].Rank = standings[i-1].Rank
+		}
+	}
+
+	for i := 1; i < len(standings); i++ {
+		if !sameRecord(standings[i-1], standings[i]) {
+			continue
+		}
+		decided := tiebreaker(standings[i-1], standings[i])
+		if standings[i-1].Tiebreaker == "" {
+			standings[i-1].Tiebreaker = decided
+		}
+		standings[i].Tiebreaker = decided
+	}
+}
+
+// tiebreaker returns the first criterion that separates two teams with the same series record
+func tiebreaker(a, b Standing) Tiebreaker {
+	switch {
+	case !sameRecord(a, b):
+		return ""
+	case a.GameDifferential != b.GameDifferential:
+		return TiebreakGameDifferential
+	case a.HeadToHeadWins != b.HeadToHeadWins:
+		return TiebreakHeadToHead
+	case a.Buchholz != b.Buchholz:
+		return TiebreakBuchholz
+	case a.GameWins != b.GameWins:
+		return TiebreakGameWins
+	}
+	return TiebreakNone
+}
+
+func sameRecord(a, b Standing) bool {
+	return a.SeriesWins == b.SeriesWins && a.SeriesLosses == b.SeriesLosses
+}
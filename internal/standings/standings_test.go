@@ -0,0 +1,119 @@
+package standings
+
+import (
+	"testing"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func team(id string) domain.MatchTeam {
+	return domain.MatchTeam{UUID: id, Name: "Team " + id}
+}
+
+func series(a, b string, scoreA, scoreB int) domain.Match {
+	return domain.Match{TeamA: team(a), TeamB: team(b), TeamAScore: scoreA, TeamBScore: scoreB, IsCompleted: true}
+}
+
+func TestComputeBracket(t *testing.T) {
+	// Round robin of four: A and B finish 2-1 with the same game differential,
+	// A beat B. D and C finish 1-2 with different game differentials.
+	bracket := domain.Bracket{
+		TournamentUUID: "group-a",
+		TournamentName: "Group A",
+		Label:          "Group A",
+		Format:         "round-robin-4",
+		Matches: []domain.Match{
+			series("A", "B", 3, 2),
+			series("A", "C", 3, 0),
+			series("A", "D", 0, 3),
+			series("B", "C", 3, 2),
+			series("B", "D", 3, 2),
+			series("C", "D", 3, 1),
+			{TeamA: team("A"), TeamB: domain.MatchTeam{Name: "TBD"}},
+		},
+	}
+
+	table := ComputeBracket(bracket)
+	assert.Equal(t, "group-a", table.BracketUUID)
+	assert.Equal(t, "round-robin-4", table.Format)
+	require.Len(t, table.Standings, 4)
+
+	a, b, d, c := table.Standings[0], table.Standings[1], table.Standings[2], table.Standings[3]
+	assert.Equal(t, []string{"A", "B", "D", "C"}, []string{a.TeamUUID, b.TeamUUID, d.TeamUUID, c.TeamUUID})
+	assert.Equal(t, []int{1, 2, 3, 4}, []int{a.Rank, b.Rank, d.Rank, c.Rank})
+
+	assert.Equal(t, 2, a.SeriesWins)
+	assert.Equal(t, 1, a.SeriesLosses)
+	assert.Equal(t, 6, a.GameWins)
+	assert.Equal(t, 5, a.GameLosses)
+	assert.Equal(t, 1, a.GameDifferential)
+	assert.Equal(t, 1, a.HeadToHeadWins)
+	assert.Equal(t, 0, b.HeadToHeadWins)
+	assert.Equal(t, TiebreakHeadToHead, a.Tiebreaker)
+	assert.Equal(t, TiebreakHeadToHead, b.Tiebreaker)
+
+	assert.Equal(t, 0, d.GameDifferential)
+	assert.Equal(t, -2, c.GameDifferential)
+	assert.Equal(t, TiebreakGameDifferential, d.Tiebreaker)
+	assert.Equal(t, TiebreakGameDifferential, c.Tiebreaker)
+
+	// Buchholz sums the series wins of all opponents
+	assert.Equal(t, 2+1+1, a.Buchholz)
+
+	// The open series against TBD is not counted as remaining
+	assert.Equal(t, 0, a.Remaining)
+}
+
+func TestComputeBracket_Buchholz(t *testing.T) {
+	// Swiss round 2: A and B are both 1-0 with the same game differential and have not met.
+	// A beat C who won again, B beat D who lost again.
+	bracket := domain.Bracket{
+		Format: "swiss-8",
+		Matches: []domain.Match{
+			series("A", "C", 3, 1),
+			series("B", "D", 3, 1),
+			series("C", "E", 3, 1),
+			series("D", "F", 1, 3),
+			{TeamA: team("A"), TeamB: team("B")},
+		},
+	}
+
+	table := ComputeBracket(bracket)
+	require.GreaterOrEqual(t, len(table.Standings), 2)
+	assert.Equal(t, "A", table.Standings[0].TeamUUID)
+	assert.Equal(t, "B", table.Standings[1].TeamUUID)
+	assert.Equal(t, TiebreakBuchholz, table.Standings[0].Tiebreaker)
+	assert.Equal(t, 1, table.Standings[0].Remaining)
+}
+
+func TestComputeBracket_Tied(t *testing.T) {
+	bracket := domain.Bracket{
+		Format: "swiss",
+		Matches: []domain.Match{
+			series("A", "C", 3, 1),
+			series("B", "D", 3, 1),
+		},
+	}
+
+	table := ComputeBracket(bracket)
+	require.Len(t, table.Standings, 4)
+	assert.Equal(t, 1, table.Standings[0].Rank)
+	assert.Equal(t, 1, table.Standings[1].Rank)
+	assert.Equal(t, TiebreakNone, table.Standings[1].Tiebreaker)
+	assert.Equal(t, 3, table.Standings[2].Rank)
+}
+
+func TestCompute(t *testing.T) {
+	brackets := []domain.Bracket{
+		{Label: "Swiss", Format: "swiss-16"},
+		{Label: "Playoffs", Format: "single-elim-8"},
+		{Label: "Groups", Format: "Round Robin"},
+	}
+
+	tables := Compute(brackets)
+	require.Len(t, tables, 2)
+	assert.Equal(t, "Swiss", tables[0].Label)
+	assert.Equal(t, "Groups", tables[1].Label)
+}
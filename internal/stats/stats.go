@@ -0,0 +1,363 @@
+// Package stats computes series and game statistics from the matches of tournaments
+package stats
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/teams"
+)
+
+const (
+	// DefaultOvertimeAfter is the game length from which a game decided by one goal likely went to overtime.
+	// Regulation is five minutes of game time, kickoffs and goal replays add about two minutes.
+	DefaultOvertimeAfter = 8 * time.Minute
+	// DefaultTop is the number of longest games and comebacks listed by default
+	DefaultTop = 5
+)
+
+// Options configure the statistics
+type Options struct {
+	// Team restricts the statistics to the series of the teams matching the query (UUID, name or shorthand)
+	Team string
+	// OvertimeAfter is the game length from which a game decided by one goal counts as likely overtime
+	OvertimeAfter time.Duration
+	// Top is the number of longest games and comebacks to list
+	Top int
+}
+
+// DefaultOptions returns the options of the statistics of all teams
+func DefaultOptions() Options {
+	return Options{OvertimeAfter: DefaultOvertimeAfter, Top: DefaultTop}
+}
+
+// Stats holds the statistics of a set of series
+type Stats struct {
+	Tournaments int
+	Series      int
+	Games       int
+	Goals       int
+	// TimedGames is the number of games with a start and end time, durations are based on them
+	TimedGames      int
+	AverageDuration time.Duration
+	// Longest lists the longest games, longest first
+	Longest []Game
+	// OvertimeGames counts games decided by one goal that lasted at least Options.OvertimeAfter
+	OvertimeGames int
+	// Sweeps counts series won without dropping a game (at least two games won)
+	Sweeps int
+	// ReverseSweeps counts series won after losing the first games up to match point (e.g. 0-3 to 4-3)
+	ReverseSweeps int
+	// Deciders counts series that went the distance (e.g. game 5 of a best of 5)
+	Deciders int
+	// Comebacks counts series won after trailing by at least two games
+	Comebacks int
+	// ComebackSeries lists the comebacks, biggest deficit first
+	ComebackSeries []Comeback
+	Teams          []Team
+}
+
+// Game is a single game of a series
+type Game struct {
+	TournamentID   string
+	TournamentName string
+	MatchUUID      string
+	Stage          string
+	Name           string
+	TeamA          string
+	TeamB          string
+	TeamAScore     int
+	TeamBScore     int
+	Duration       time.Duration
+	Overtime       bool
+}
+
+// Comeback is a series won after trailing by at least two games
+type Comeback struct {
+	TournamentID   string
+	TournamentName string
+	MatchUUID      string
+	Stage          string
+	Time           time.Time
+	Winner         string
+	Loser          string
+	WinnerScore    int
+	LoserScore     int
+	// Deficit is the largest number of games the winner trailed by
+	Deficit      int
+	ReverseSweep bool
+}
+
+// Team holds the statistics of a single team
+type Team struct {
+	UUID             string
+	Name             string
+	Shorthand        string
+	Series           int
+	SeriesWins       int
+	Games            int
+	GameWins         int
+	GoalsFor         int
+	GoalsAgainst     int
+	GoalDifferential int
+	// Sweeps counts series the team swept, Swept series it was swept in
+	Sweeps        int
+	Swept         int
+	Deciders      int
+	DecidersWon   int
+	ReverseSweeps int
+	Comebacks     int
+	OvertimeGames int
+	OvertimeWins  int
+	// AverageDuration is the average length of the timed games of the team
+	AverageDuration time.Duration
+
+	timedGames    int
+	totalDuration time.Duration
+}
+
+// Compute collects the statistics of all finished series of the tournaments.
+// Games with equal scores have not been played and are ignored.
+func Compute(tournaments []domain.TournamentMatches, opts Options) Stats {
+	var stats Stats
+	byTeam := make(map[string]*Team)
+	var totalDuration time.Duration
+	var games []Game
+
+	team := func(t domain.MatchTeam) *Team {
+		entry, ok := byTeam[t.UUID]
+		if !ok {
+			entry = &Team{UUID: t.UUID}
+			byTeam[t.UUID] = entry
+		}
+		entry.Name, entry.Shorthand = t.Name, t.Shorthand
+		return entry
+	}
+
+	for _, t := range tournaments {
+		counted := false
+		for _, match := range t.Matches {
+			if !match.IsOver() || match.TeamAScore == match.TeamBScore || match.TeamA.UUID == "" || match.TeamB.UUID == "" {
+				continue
+			}
+			if opts.Team != "" && !teams.IsParticipant(match.TeamA, opts.Team) && !teams.IsParticipant(match.TeamB, opts.Team) {
+				continue
+			}
+			if !counted {
+				stats.Tournaments++
+				counted = true
+			}
+
+			stats.Series++
+			a, b := team(match.TeamA), team(match.TeamB)
+			a.Series++
+			b.Series++
+
+			winner, loser := a, b
+			winnerScore, loserScore := match.TeamAScore, match.TeamBScore
+			if match.TeamBScore > match.TeamAScore {
+				winner, loser = b, a
+				winnerScore, loserScore = loserScore, winnerScore
+			}
+			winner.SeriesWins++
+
+			if loserScore == 0 && winnerScore >= 2 {
+				stats.Sweeps++
+				winner.Sweeps++
+				loser.Swept++
+			}
+			if bestOf := match.BestOf(); bestOf > 1 && winnerScore+loserScore == bestOf {
+				stats.Deciders++
+				a.Deciders++
+				b.Deciders++
+				winner.DecidersWon++
+			}
+
+			played := playedGames(match)
+			for _, game := range played {
+				stats.Games++
+				stats.Goals += game.TeamAScore + game.TeamBScore
+				a.Games++
+				b.Games++
+				a.GoalsFor += game.TeamAScore
+				a.GoalsAgainst += game.TeamBScore
+				b.GoalsFor += game.TeamBScore
+				b.GoalsAgainst += game.TeamAScore
+				gameWinner := a
+				if game.TeamBScore > game.TeamAScore {
+					gameWinner = b
+				}
+				gameWinner.GameWins++
+
+				g := Game{
+					TournamentID:   t.Tournament.ID,
+					TournamentName: t.Tournament.Name,
+					MatchUUID:      match.UUID,
+					Stage:          match.Name,
+					Name:           game.Name,
+					TeamA:          match.TeamA.Name,
+					TeamB:          match.TeamB.Name,
+					TeamAScore:     game.TeamAScore,
+					TeamBScore:     game.TeamBScore,
+				}
+				if duration, ok := gameDuration(game); ok {
+					g.Duration = duration
+					stats.TimedGames++
+					totalDuration += duration
+					for _, entry := range []*Team{a, b} {
+						entry.timedGames++
+						entry.totalDuration += duration
+					}
+					if duration >= opts.OvertimeAfter && abs(game.TeamAScore-game.TeamBScore) == 1 {
+						g.Overtime = true
+						stats.OvertimeGames++
+						a.OvertimeGames++
+						b.OvertimeGames++
+						gameWinner.OvertimeWins++
+					}
+					games = append(games, g)
+				}
+			}
+
+			deficit, reverseSweep := comeback(match, played, winner == a)
+			if deficit >= 2 {
+				stats.Comebacks++
+				winner.Comebacks++
+				if reverseSweep {
+					stats.ReverseSweeps++
+					winner.ReverseSweeps++
+				}
+				stats.ComebackSeries = append(stats.ComebackSeries, Comeback{
+					TournamentID:   t.Tournament.ID,
+					TournamentName: t.Tournament.Name,
+					MatchUUID:      match.UUID,
+					Stage:          match.Name,
+					Time:           match.TimeOfSeries,
+					Winner:         winner.Name,
+					Loser:          loser.Name,
+					WinnerScore:    winnerScore,
+					LoserScore:     loserScore,
+					Deficit:        deficit,
+					ReverseSweep:   reverseSweep,
+				})
+			}
+		}
+	}
+
+	if stats.TimedGames > 0 {
+		stats.AverageDuration = totalDuration / time.Duration(stats.TimedGames)
+	}
+
+	sort.SliceStable(games, func(i, j int) bool {
+		return games[i].Duration > games[j].Duration
+	})
+	stats.Longest = top(games, opts.Top)
+
+	sort.SliceStable(stats.ComebackSeries, func(i, j int) bool {
+		a, b := stats.ComebackSeries[i], stats.ComebackSeries[j]
+		if a.Deficit != b.Deficit {
+			return a.Deficit > b.Deficit
+		}
+		return a.Time.After(b.Time)
+	})
+	stats.ComebackSeries = top(stats.ComebackSeries, opts.Top)
+
+	for _, entry := range byTeam {
+		if opts.Team != "" && !teams.IsParticipant(domain.MatchTeam{UUID: entry.UUID, Name: entry.Name, Shorthand: entry.Shorthand}, opts.Team) {
+			continue
+		}
+		entry.GoalDifferential = entry.GoalsFor - entry.GoalsAgainst
+		if entry.timedGames > 0 {
+			entry.AverageDuration = entry.totalDuration / time.Duration(entry.timedGames)
+		}
+		stats.Teams = append(stats.Teams, *entry)
+	}
+	sort.Slice(stats.Teams, func(i, j int) bool {
+		a, b := stats.Teams[i], stats.Teams[j]
+		if a.SeriesWins != b.SeriesWins {
+			return a.SeriesWins > b.SeriesWins
+		}
+		if a.GoalDifferential != b.GoalDifferential {
+			return a.GoalDifferential > b.GoalDifferential
+		}
+		return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+	})
+
+	return stats
+}
+
+// playedGames returns the games of a series that were played, in the order they were played
+func playedGames(match domain.Match) []domain.MatchMap {
+	played := make([]domain.MatchMap, 0, len(match.Maps))
+	for _, game := range match.Maps {
+		// Rocket League games cannot end in a draw, equal scores have not been played
+		if game.TeamAScore != game.TeamBScore {
+			played = append(played, game)
+		}
+	}
+	// Keep the order of the data unless every game has a start time
+	for _, game := range played {
+		if game.ActualStartTime.IsZero() {
+			return played
+		}
+	}
+	sort.SliceStable(played, func(i, j int) bool {
+		return played[i].ActualStartTime.Before(played[j].ActualStartTime)
+	})
+	return played
+}
+
+// gameDuration returns the length of a game from its start to its end
+func gameDuration(game domain.MatchMap) (time.Duration, bool) {
+	if game.ActualStartTime.IsZero() || game.MatchEndedTime.IsZero() || !game.MatchEndedTime.After(game.ActualStartTime) {
+		return 0, false
+	}
+	return game.MatchEndedTime.Sub(game.ActualStartTime), true
+}
+
+// comeback returns the largest number of games the winner of a series trailed by and whether
+// the series was a reverse sweep: the loser won the first games up to match point, the winner
+// every game after that, and at least three games were needed to win
+func comeback(match domain.Match, played []domain.MatchMap, winnerIsA bool) (int, bool) {
+	winnerGames, loserGames, deficit := 0, 0, 0
+	reverseSweep := true
+	for _, game := range played {
+		wonByWinner := (game.TeamAScore > game.TeamBScore) == winnerIsA
+		if wonByWinner {
+			winnerGames++
+		} else {
+			loserGames++
+			if winnerGames > 0 {
+				reverseSweep = false
+			}
+		}
+		if loserGames-winnerGames > deficit {
+			deficit = loserGames - winnerGames
+		}
+	}
+
+	need := match.TeamAScore
+	if match.TeamBScore > need {
+		need = match.TeamBScore
+	}
+	if winnerGames != need || loserGames != need-1 || need < 3 || deficit != need-1 {
+		reverseSweep = false
+	}
+	return deficit, reverseSweep
+}
+
+func top[T any](items []T, n int) []T {
+	if n >= 0 && len(items) > n {
+		return items[:n]
+	}
+	return items
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,135 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var start = time.Date(2026, 3, 1, 14, 0, 0, 0, time.UTC)
+
+// game returns a game that starts minutes after start and lasts length
+func game(minutes int, length time.Duration, scoreA, scoreB int) domain.MatchMap {
+	begin := start.Add(time.Duration(minutes) * time.Minute)
+	return domain.MatchMap{Name: "Game", ActualStartTime: begin, MatchEndedTime: begin.Add(length), TeamAScore: scoreA, TeamBScore: scoreB}
+}
+
+// series returns a completed series whose score is derived from its games
+func series(uuid, matchType, teamA, teamB string, games ...domain.MatchMap) domain.Match {
+	match := domain.Match{
+		UUID:         uuid,
+		Name:         uuid,
+		Type:         matchType,
+		TimeOfSeries: start,
+		TeamA:        domain.MatchTeam{UUID: teamA, Name: "Team " + teamA, Shorthand: teamA},
+		TeamB:        domain.MatchTeam{UUID: teamB, Name: "Team " + teamB, Shorthand: teamB},
+		Maps:         games,
+		IsCompleted:  true,
+	}
+	for _, g := range games {
+		if g.TeamAScore > g.TeamBScore {
+			match.TeamAScore++
+		} else if g.TeamBScore > g.TeamAScore {
+			match.TeamBScore++
+		}
+	}
+	return match
+}
+
+func testTournaments() []domain.TournamentMatches {
+	return []domain.TournamentMatches{{
+		Tournament: domain.Tournament{ID: "major", Name: "Major"},
+		Matches: []domain.Match{
+			// A sweeps B
+			series("sweep", "BO5", "A", "B",
+				game(0, 6*time.Minute, 3, 0), game(10, 7*time.Minute, 2, 1), game(20, 6*time.Minute, 4, 2)),
+			// C reverse sweeps A from 0-2, game 2 went to overtime, an unplayed game is listed too
+			series("reverse", "BO5", "A", "C",
+				game(0, 6*time.Minute, 2, 0), game(10, 11*time.Minute, 3, 2), game(30, 6*time.Minute, 0, 1),
+				game(40, 7*time.Minute, 1, 3), game(50, 6*time.Minute, 2, 4), domain.MatchMap{Name: "Game 6"}),
+			// B wins a decider without trailing by two
+			series("decider", "BO3", "B", "C",
+				game(0, 6*time.Minute, 1, 0), game(10, 6*time.Minute, 0, 1), game(20, 9*time.Minute, 1, 0)),
+			// Series between two games are ignored until they are decided
+			series("paused", "BO7", "C", "B",
+				game(0, 6*time.Minute, 5, 0), game(10, 6*time.Minute, 4, 0)),
+			// Upcoming series are ignored
+			{UUID: "upcoming", TeamA: domain.MatchTeam{UUID: "A"}, TeamB: domain.MatchTeam{UUID: "B"}},
+		},
+	}}
+}
+
+func TestCompute(t *testing.T) {
+	stats := Compute(testTournaments(), DefaultOptions())
+
+	assert.Equal(t, 1, stats.Tournaments)
+	assert.Equal(t, 3, stats.Series)
+	assert.Equal(t, 11, stats.Games)
+	assert.Equal(t, 11, stats.TimedGames)
+	assert.Equal(t, 76*time.Minute/11, stats.AverageDuration)
+	assert.Equal(t, 1, stats.Sweeps)
+	assert.Equal(t, 1, stats.ReverseSweeps)
+	assert.Equal(t, 2, stats.Deciders)
+	assert.Equal(t, 1, stats.Comebacks)
+	// Game 2 of the reverse sweep and game 3 of the decider were decided by one goal after 8 minutes
+	assert.Equal(t, 2, stats.OvertimeGames)
+
+	require.Len(t, stats.Longest, 5)
+	assert.Equal(t, "reverse", stats.Longest[0].MatchUUID)
+	assert.Equal(t, 11*time.Minute, stats.Longest[0].Duration)
+	assert.True(t, stats.Longest[0].Overtime)
+
+	require.Len(t, stats.ComebackSeries, 1)
+	assert.Equal(t, Comeback{
+		TournamentID: "major", TournamentName: "Major", MatchUUID: "reverse", Stage: "reverse", Time: start,
+		Winner: "Team C", Loser: "Team A", WinnerScore: 3, LoserScore: 2, Deficit: 2, ReverseSweep: true,
+	}, stats.ComebackSeries[0])
+
+	require.Len(t, stats.Teams, 3)
+	// A and C won a series each, A has the better goal differential
+	a := stats.Teams[0]
+	assert.Equal(t, "A", a.UUID)
+	assert.Equal(t, 8, a.Games)
+	assert.Equal(t, 17, a.GoalsFor)
+	assert.Equal(t, 13, a.GoalsAgainst)
+	assert.Equal(t, 4, a.GoalDifferential)
+	assert.Equal(t, 1, a.Sweeps)
+	assert.Equal(t, 1, a.OvertimeWins)
+	assert.Equal(t, 55*time.Minute/8, a.AverageDuration)
+
+	c := stats.Teams[1]
+	assert.Equal(t, "C", c.UUID)
+	assert.Equal(t, 1, c.SeriesWins)
+	assert.Equal(t, 1, c.ReverseSweeps)
+	assert.Equal(t, 1, c.Comebacks)
+	assert.Equal(t, 2, c.Deciders)
+	assert.Equal(t, 1, c.DecidersWon)
+	assert.Equal(t, 2, c.OvertimeGames)
+	assert.Equal(t, 0, c.OvertimeWins)
+
+	assert.Equal(t, 1, stats.Teams[2].Swept)
+}
+
+func TestCompute_Team(t *testing.T) {
+	opts := DefaultOptions()
+	opts.Team = "b"
+	opts.Top = 1
+	stats := Compute(testTournaments(), opts)
+
+	assert.Equal(t, 2, stats.Series)
+	assert.Equal(t, 6, stats.Games)
+	require.Len(t, stats.Teams, 1)
+	assert.Equal(t, "B", stats.Teams[0].UUID)
+	assert.Len(t, stats.Longest, 1)
+	assert.Empty(t, stats.ComebackSeries)
+}
+
+func TestCompute_Empty(t *testing.T) {
+	stats := Compute(nil, DefaultOptions())
+	assert.Zero(t, stats.Series)
+	assert.Zero(t, stats.AverageDuration)
+	assert.Empty(t, stats.Teams)
+}
@@ -0,0 +1,131 @@
+package teams
+
+import (
+	"sort"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+)
+
+// HeadToHead is the record of all completed series between two teams.
+// Scores are given from the point of view of team A.
+type HeadToHead struct {
+	TeamA       string
+	TeamB       string
+	SeriesWinsA int
+	SeriesWinsB int
+	GameWinsA   int
+	GameWinsB   int
+	GoalsA      int
+	GoalsB      int
+	GamesPlayed int
+	// GoalDifferentialPerGame is the average goal differential of all games with scores
+	GoalDifferentialPerGame float64
+	// Meetings lists the series between the teams, most recent first
+	Meetings []Meeting
+}
+
+// Meeting is a completed series between two teams
+type Meeting struct {
+	TournamentID   string
+	TournamentName string
+	MatchUUID      string
+	Stage          string
+	Time           time.Time
+	ScoreA         int
+	ScoreB         int
+	GoalsA         int
+	GoalsB         int
+}
+
+// IsParticipant returns true if the UUID of a match participant equals query
+// or its name or shorthand contains query (case-insensitive). An empty query matches no one.
+func IsParticipant(team domain.MatchTeam, query string) bool {
+	if query == "" {
+		return false
+	}
+	return team.UUID == query || Matches(team, query)
+}
+
+// CompareHeadToHead collects all completed series between teamA and teamB, matched by UUID.
+// Up to last meetings are kept, all of them count towards the record.
+func CompareHeadToHead(tournaments []domain.TournamentMatches, teamA, teamB Team, last int) HeadToHead {
+	h2h := HeadToHead{TeamA: teamA.Name, TeamB: teamB.Name}
+	var named time.Time
+
+	var meetings []Meeting
+	for _, t := range tournaments {
+		for _, match := range t.Matches {
+			if !match.IsOver() {
+				continue
+			}
+
+			sideA, sideB := match.TeamA, match.TeamB
+			scoreA, scoreB := match.TeamAScore, match.TeamBScore
+			flipped := false
+			if sideA.UUID != teamA.UUID || sideB.UUID != teamB.UUID {
+				if sideB.UUID != teamA.UUID || sideA.UUID != teamB.UUID {
+					continue
+				}
+				sideA, sideB = sideB, sideA
+				scoreA, scoreB = scoreB, scoreA
+				flipped = true
+			}
+
+			// Name the teams after their most recent meeting
+			if len(meetings) == 0 || !match.TimeOfSeries.Before(named) {
+				named = match.TimeOfSeries
+				h2h.TeamA = sideA.Name
+				h2h.TeamB = sideB.Name
+			}
+
+			meeting := Meeting{
+				TournamentID:   t.Tournament.ID,
+				TournamentName: t.Tournament.Name,
+				MatchUUID:      match.UUID,
+				Stage:          match.Name,
+				Time:           match.TimeOfSeries,
+				ScoreA:         scoreA,
+				ScoreB:         scoreB,
+			}
+			for _, game := range match.Maps {
+				// Rocket League games cannot end in a draw, equal scores have not been played
+				if game.TeamAScore == game.TeamBScore {
+					continue
+				}
+				goalsA, goalsB := game.TeamAScore, game.TeamBScore
+				if flipped {
+					goalsA, goalsB = goalsB, goalsA
+				}
+				meeting.GoalsA += goalsA
+				meeting.GoalsB += goalsB
+				h2h.GamesPlayed++
+			}
+
+			h2h.GameWinsA += scoreA
+			h2h.GameWinsB += scoreB
+			h2h.GoalsA += meeting.GoalsA
+			h2h.GoalsB += meeting.GoalsB
+			if scoreA > scoreB {
+				h2h.SeriesWinsA++
+			} else if scoreB > scoreA {
+				h2h.SeriesWinsB++
+			}
+			meetings = append(meetings, meeting)
+		}
+	}
+
+	if h2h.GamesPlayed > 0 {
+		h2h.GoalDifferentialPerGame = float64(h2h.GoalsA-h2h.GoalsB) / float64(h2h.GamesPlayed)
+	}
+
+	sort.SliceStable(meetings, func(i, j int) bool {
+		return meetings[i].Time.After(meetings[j].Time)
+	})
+	if len(meetings) > last {
+		meetings = meetings[:last]
+	}
+	h2h.Meetings = meetings
+
+	return h2h
+}
@@ -0,0 +1,63 @@
+package teams
+
+import (
+	"testing"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareHeadToHead(t *testing.T) {
+	tournaments := testTournaments()
+	tournaments[0].Matches[0].Maps = []domain.MatchMap{
+		{TeamAScore: 3, TeamBScore: 1},
+		{TeamAScore: 2, TeamBScore: 4},
+		{TeamAScore: 0, TeamBScore: 0},
+	}
+	tournaments[1].Matches = append(tournaments[1].Matches, domain.Match{
+		UUID: "p1", Name: "Playoffs", TeamA: karmine, TeamB: vitality, TeamAScore: 4, TeamBScore: 3, IsCompleted: true, TimeOfSeries: day(13),
+	}, domain.Match{
+		UUID: "p2", Name: "Rematch", Type: "BO7", TeamA: vitality, TeamB: karmine, TeamAScore: 2, TeamBScore: 1, IsCompleted: true, TimeOfSeries: day(14),
+	})
+	vit := Team{UUID: "vit", Name: "Team Vitality"}
+	kc := Team{UUID: "kc", Name: "Karmine Corp"}
+
+	// Teams are oriented by the order of the arguments
+	h2h := CompareHeadToHead(tournaments, vit, kc, 10)
+	assert.Equal(t, "Team Vitality", h2h.TeamA)
+	assert.Equal(t, "Karmine Corp", h2h.TeamB)
+	assert.Equal(t, 1, h2h.SeriesWinsA)
+	assert.Equal(t, 1, h2h.SeriesWinsB)
+	assert.Equal(t, 7, h2h.GameWinsA)
+	assert.Equal(t, 6, h2h.GameWinsB)
+	assert.Equal(t, 5, h2h.GoalsA)
+	assert.Equal(t, 5, h2h.GoalsB)
+	assert.Equal(t, 2, h2h.GamesPlayed)
+	assert.Equal(t, 0.0, h2h.GoalDifferentialPerGame)
+
+	require.Len(t, h2h.Meetings, 2)
+	assert.Equal(t, "p1", h2h.Meetings[0].MatchUUID)
+	assert.Equal(t, 3, h2h.Meetings[0].ScoreA)
+	assert.Equal(t, 4, h2h.Meetings[0].ScoreB)
+	assert.Equal(t, "m1", h2h.Meetings[1].MatchUUID)
+
+	// The live series and the series between two games are not meetings yet
+	h2h = CompareHeadToHead(tournaments, kc, vit, 1)
+	assert.Equal(t, 1, h2h.SeriesWinsA)
+	assert.Equal(t, 5, h2h.GoalsA)
+	require.Len(t, h2h.Meetings, 1)
+	assert.Equal(t, 4, h2h.Meetings[0].ScoreA)
+
+	h2h = CompareHeadToHead(tournaments, Team{UUID: "fal", Name: "Team Falcons"}, Team{UUID: "g2", Name: "G2 Esports"}, 10)
+	assert.Empty(t, h2h.Meetings)
+	assert.Equal(t, "Team Falcons", h2h.TeamA)
+}
+
+func TestIsParticipant(t *testing.T) {
+	assert.True(t, IsParticipant(karmine, "kc"))
+	assert.True(t, IsParticipant(karmine, "Karmine"))
+	assert.True(t, IsParticipant(falcons, "fal"))
+	assert.False(t, IsParticipant(tbd, ""))
+	assert.False(t, IsParticipant(karmine, "vit"))
+}
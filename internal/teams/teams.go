@@ -0,0 +1,221 @@
+// Package teams aggregates team records from the matches of tournaments
+package teams
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+)
+
+// DefaultRecent is the number of recent results kept per team by default
+const DefaultRecent = 5
+
+// Team is the record of a team aggregated across the matches of many tournaments
+type Team struct {
+	UUID      string
+	Name      string
+	Shorthand string
+	Location  string
+	// Regions lists the regions of the regional tournaments the team played in
+	Regions []domain.Region
+	// Tournaments lists the tournaments the team has matches in, in order of their start
+	Tournaments      []Tournament
+	SeriesWins       int
+	SeriesLosses     int
+	GameWins         int
+	GameLosses       int
+	GameDifferential int
+	// Recent lists the most recent completed series, most recent first
+	Recent []Result
+}
+
+// Tournament is a tournament attended by a team
+type Tournament struct {
+	ID        string
+	Name      string
+	StartDate time.Time
+}
+
+// Result is the outcome of a completed series from the point of view of a team
+type Result struct {
+	TournamentID   string
+	TournamentName string
+	MatchUUID      string
+	Stage          string
+	Time           time.Time
+	Opponent       string
+	Score          int
+	OpponentScore  int
+	Won            bool
+}
+
+// HasRegion returns true if the team played a regional tournament of the region (case-insensitive)
+func (t *Team) HasRegion(region string) bool {
+	for _, r := range t.Regions {
+		if strings.EqualFold(string(r), region) {
+			return true
+		}
+	}
+	return false
+}
+
+// Aggregate builds the records of all teams that appear in the matches of the tournaments.
+// Teams are identified by UUID, participants without one (e.g., TBD slots) are ignored.
+// Up to recent completed series are kept per team.
+// Teams are sorted by series wins, game differential and name.
+func Aggregate(tournaments []domain.TournamentMatches, recent int) []Team {
+	type entry struct {
+		team    *Team
+		seen    time.Time
+		results []Result
+		events  map[string]bool
+		regions map[domain.Region]bool
+	}
+	entries := make(map[string]*entry)
+
+	for _, t := range tournaments {
+		for _, match := range t.Matches {
+			for _, side := range []struct {
+				team, opponent domain.MatchTeam
+				score, against int
+			}{
+				{match.TeamA, match.TeamB, match.TeamAScore, match.TeamBScore},
+				{match.TeamB, match.TeamA, match.TeamBScore, match.TeamAScore},
+			} {
+				if side.team.UUID == "" {
+					continue
+				}
+
+				e, ok := entries[side.team.UUID]
+				if !ok {
+					e = &entry{
+						team:    &Team{UUID: side.team.UUID},
+						events:  make(map[string]bool),
+						regions: make(map[domain.Region]bool),
+					}
+					entries[side.team.UUID] = e
+				}
+
+				// Teams rename between events, the most recent identity wins
+				if !ok || !match.TimeOfSeries.Before(e.seen) {
+					e.seen = match.TimeOfSeries
+					e.team.Name = side.team.Name
+					e.team.Shorthand = side.team.Shorthand
+					e.team.Location = side.team.Location
+				}
+
+				if !e.events[t.Tournament.ID] {
+					e.events[t.Tournament.ID] = true
+					e.team.Tournaments = append(e.team.Tournaments, Tournament{
+						ID:        t.Tournament.ID,
+						Name:      t.Tournament.Name,
+						StartDate: t.Tournament.StartDate,
+					})
+				}
+				if t.Tournament.Region != domain.RegionNone {
+					e.regions[t.Tournament.Region] = true
+				}
+
+				if !match.IsOver() {
+					continue
+				}
+
+				e.team.GameWins += side.score
+				e.team.GameLosses += side.against
+				if side.score > side.against {
+					e.team.SeriesWins++
+				} else if side.score < side.against {
+					e.team.SeriesLosses++
+				}
+
+				e.results = append(e.results, Result{
+					TournamentID:   t.Tournament.ID,
+					TournamentName: t.Tournament.Name,
+					MatchUUID:      match.UUID,
+					Stage:          match.Name,
+					Time:           match.TimeOfSeries,
+					Opponent:       side.opponent.Name,
+					Score:          side.score,
+					OpponentScore:  side.against,
+					Won:            side.score > side.against,
+				})
+			}
+		}
+	}
+
+	teams := make([]Team, 0, len(entries))
+	for _, e := range entries {
+		team := e.team
+		team.GameDifferential = team.GameWins - team.GameLosses
+
+		for region := range e.regions {
+			team.Regions = append(team.Regions, region)
+		}
+		sort.Slice(team.Regions, func(i, j int) bool {
+			return team.Regions[i] < team.Regions[j]
+		})
+
+		sort.SliceStable(team.Tournaments, func(i, j int) bool {
+			return team.Tournaments[i].StartDate.Before(team.Tournaments[j].StartDate)
+		})
+
+		sort.SliceStable(e.results, func(i, j int) bool {
+			return e.results[i].Time.After(e.results[j].Time)
+		})
+		if len(e.results) > recent {
+			e.results = e.results[:recent]
+		}
+		team.Recent = e.results
+
+		teams = append(teams, *team)
+	}
+
+	sort.Slice(teams, func(i, j int) bool {
+		a, b := teams[i], teams[j]
+		if a.SeriesWins != b.SeriesWins {
+			return a.SeriesWins > b.SeriesWins
+		}
+		if a.GameDifferential != b.GameDifferential {
+			return a.GameDifferential > b.GameDifferential
+		}
+		if !strings.EqualFold(a.Name, b.Name) {
+			return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+		}
+		return a.UUID < b.UUID
+	})
+
+	return teams
+}
+
+// Find returns the teams identified by query.
+// An exact UUID match wins over an exact (case-insensitive) name or shorthand match,
+// which wins over a partial name or shorthand match.
+func Find(teams []Team, query string) []Team {
+	for _, team := range teams {
+		if team.UUID == query {
+			return []Team{team}
+		}
+	}
+
+	var exact, partial []Team
+	for _, team := range teams {
+		if strings.EqualFold(team.Name, query) || strings.EqualFold(team.Shorthand, query) {
+			exact = append(exact, team)
+		} else if Matches(domain.MatchTeam{Name: team.Name, Shorthand: team.Shorthand}, query) {
+			partial = append(partial, team)
+		}
+	}
+	if len(exact) > 0 {
+		return exact
+	}
+	return partial
+}
+
+// Matches returns true if the name or shorthand of a match participant contains query (case-insensitive)
+func Matches(team domain.MatchTeam, query string) bool {
+	query = strings.ToLower(query)
+	return strings.Contains(strings.ToLower(team.Name), query) ||
+		strings.Contains(strings.ToLower(team.Shorthand), query)
+}
@@ -0,0 +1,129 @@
+package teams
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	vitality = domain.MatchTeam{UUID: "vit", Name: "Team Vitality", Shorthand: "VIT", Location: "FR"}
+	karmine  = domain.MatchTeam{UUID: "kc", Name: "Karmine Corp", Shorthand: "KC", Location: "FR"}
+	falcons  = domain.MatchTeam{UUID: "fal", Name: "Team Falcons", Shorthand: "FLCN", Location: "SA"}
+	tbd      = domain.MatchTeam{Name: "TBD"}
+)
+
+func day(d int) time.Time {
+	return time.Date(2026, 1, d, 18, 0, 0, 0, time.UTC)
+}
+
+func testTournaments() []domain.TournamentMatches {
+	return []domain.TournamentMatches{
+		{
+			Tournament: domain.Tournament{ID: "open-1", Name: "Open 1 EU", Region: domain.RegionEU, StartDate: day(1)},
+			Matches: []domain.Match{
+				{UUID: "m1", Name: "Semifinal", TeamA: vitality, TeamB: karmine, TeamAScore: 4, TeamBScore: 2, IsCompleted: true, TimeOfSeries: day(2)},
+				{UUID: "m2", Name: "Final", TeamA: vitality, TeamB: tbd, TimeOfSeries: day(3)},
+			},
+		},
+		{
+			Tournament: domain.Tournament{ID: "major-1", Name: "Major 1", StartDate: day(10)},
+			Matches: []domain.Match{
+				{UUID: "m3", Name: "Swiss R1", TeamA: falcons, TeamB: karmine, TeamAScore: 1, TeamBScore: 3, IsCompleted: true, TimeOfSeries: day(10)},
+				{UUID: "m4", Name: "Swiss R2", TeamA: vitality, TeamB: falcons, TeamAScore: 3, TeamBScore: 0, IsCompleted: true, TimeOfSeries: day(11)},
+				{UUID: "m5", Name: "Swiss R3", TeamA: karmine, TeamB: vitality, TeamAScore: 1, TeamBScore: 0, IsLive: true, TimeOfSeries: day(12)},
+				// Between two games of a series that is not decided yet
+				{UUID: "m6", Name: "Swiss R4", Type: "BO5", TeamA: falcons, TeamB: karmine, TeamAScore: 2, TeamBScore: 1, IsCompleted: true, TimeOfSeries: day(11)},
+			},
+		},
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	teams := Aggregate(testTournaments(), DefaultRecent)
+	require.Len(t, teams, 3)
+
+	vit := teams[0]
+	assert.Equal(t, "vit", vit.UUID)
+	assert.Equal(t, 2, vit.SeriesWins)
+	assert.Equal(t, 0, vit.SeriesLosses)
+	assert.Equal(t, 7, vit.GameWins)
+	assert.Equal(t, 2, vit.GameLosses)
+	assert.Equal(t, 5, vit.GameDifferential)
+	assert.Equal(t, []domain.Region{domain.RegionEU}, vit.Regions)
+	require.Len(t, vit.Tournaments, 2)
+	assert.Equal(t, "open-1", vit.Tournaments[0].ID)
+	assert.Equal(t, "major-1", vit.Tournaments[1].ID)
+
+	// Live series do not count, recent results are most recent first
+	kc := teams[1]
+	assert.Equal(t, "kc", kc.UUID)
+	assert.Equal(t, 1, kc.SeriesWins)
+	assert.Equal(t, 1, kc.SeriesLosses)
+	require.Len(t, kc.Recent, 2)
+	assert.Equal(t, Result{
+		TournamentID:   "major-1",
+		TournamentName: "Major 1",
+		MatchUUID:      "m3",
+		Stage:          "Swiss R1",
+		Time:           day(10),
+		Opponent:       "Team Falcons",
+		Score:          3,
+		OpponentScore:  1,
+		Won:            true,
+	}, kc.Recent[0])
+	assert.False(t, kc.Recent[1].Won)
+
+	fal := teams[2]
+	assert.Equal(t, "fal", fal.UUID)
+	assert.Empty(t, fal.Regions)
+	assert.Equal(t, -5, fal.GameDifferential)
+
+	assert.True(t, vit.HasRegion("eu"))
+	assert.False(t, fal.HasRegion("EU"))
+}
+
+func TestAggregate_RecentLimitAndRename(t *testing.T) {
+	tournaments := testTournaments()
+	renamed := karmine
+	renamed.Name = "KC Blue"
+	tournaments[1].Matches[2].TeamA = renamed
+
+	teams := Aggregate(tournaments, 1)
+	for _, team := range teams {
+		assert.LessOrEqual(t, len(team.Recent), 1)
+		if team.UUID == "kc" {
+			assert.Equal(t, "KC Blue", team.Name)
+		}
+	}
+}
+
+func TestFind(t *testing.T) {
+	teams := Aggregate(testTournaments(), DefaultRecent)
+
+	found := Find(teams, "kc")
+	require.Len(t, found, 1)
+	assert.Equal(t, "kc", found[0].UUID)
+
+	found = Find(teams, "KC")
+	require.Len(t, found, 1)
+	assert.Equal(t, "Karmine Corp", found[0].Name)
+
+	found = Find(teams, "team")
+	assert.Len(t, found, 2)
+
+	found = Find(teams, "falc")
+	require.Len(t, found, 1)
+	assert.Equal(t, "fal", found[0].UUID)
+
+	assert.Empty(t, Find(teams, "G2"))
+}
+
+func TestMatches(t *testing.T) {
+	assert.True(t, Matches(vitality, "vit"))
+	assert.True(t, Matches(vitality, "VITALITY"))
+	assert.False(t, Matches(vitality, "kc"))
+}
@@ -0,0 +1,173 @@
+// Package watch detects score changes between successive polls of matches
+package watch
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+)
+
+const (
+	// DefaultInterval is the time between polls while a match is live
+	DefaultInterval = 30 * time.Second
+	// idleFactor slows polling down while no match is live, e.g. every five minutes for the default interval
+	idleFactor = 10
+)
+
+// EventKind identifies what changed in a match
+type EventKind string
+
+const (
+	EventSeriesStarted EventKind = "series_started"
+	EventGameStarted   EventKind = "game_started"
+	EventGameWon       EventKind = "game_won"
+	EventSeriesOver    EventKind = "series_over"
+)
+
+// Event is a change of a match between two polls
+type Event struct {
+	Kind      EventKind
+	MatchUUID string
+	// Series names the match, e.g. "Team Vitality vs Karmine Corp"
+	Series  string
+	Message string
+}
+
+// String returns the event prefixed with its series
+func (e Event) String() string {
+	return e.Series + ": " + e.Message
+}
+
+// Diff returns the events that happened between the previous and the current state of the matches.
+// Matches are identified by UUID, matches that are not part of the previous state produce no events.
+// Games won are derived from the series score, games started from the start time of each game.
+func Diff(previous, current []domain.Match) []Event {
+	before := make(map[string]domain.Match, len(previous))
+	for _, match := range previous {
+		before[match.UUID] = match
+	}
+
+	var events []Event
+	for _, match := range current {
+		old, ok := before[match.UUID]
+		if !ok {
+			continue
+		}
+		events = append(events, diffMatch(old, match)...)
+	}
+	return events
+}
+
+// diffMatch returns the events of a single match in the order they happened
+func diffMatch(old, match domain.Match) []Event {
+	series := Series(match)
+	event := func(kind EventKind, format string, args ...any) Event {
+		return Event{Kind: kind, MatchUUID: match.UUID, Series: series, Message: fmt.Sprintf(format, args...)}
+	}
+
+	var events []Event
+	if match.IsInProgress() && !old.IsInProgress() && !old.IsOver() {
+		events = append(events, event(EventSeriesStarted, "Series started"))
+	}
+
+	// Report each game won since the last poll, in the order the games ended
+	scoreA, scoreB := old.TeamAScore, old.TeamBScore
+	win := func(teamA bool) {
+		winner := match.TeamA
+		if teamA {
+			scoreA++
+		} else {
+			winner = match.TeamB
+			scoreB++
+		}
+		events = append(events, event(EventGameWon, "%s wins game %d (%d-%d)", teamName(winner), scoreA+scoreB, scoreA, scoreB))
+	}
+	for _, game := range endedGames(old, match) {
+		teamA := game.TeamAScore > game.TeamBScore
+		if (teamA && scoreA < match.TeamAScore) || (!teamA && scoreB < match.TeamBScore) {
+			win(teamA)
+		}
+	}
+	// Games without an end time are credited from the series score, the leader wins the last of them
+	leaderA := match.TeamAScore >= match.TeamBScore
+	for scoreA < match.TeamAScore || scoreB < match.TeamBScore {
+		trailerDone := (leaderA && scoreB == match.TeamBScore) || (!leaderA && scoreA == match.TeamAScore)
+		win(leaderA == trailerDone)
+	}
+
+	// Between two games a series looks completed, it is only over once the series score decides it
+	if match.IsOver() && !old.IsOver() {
+		events = append(events, event(EventSeriesOver, "Series over %d-%d", match.TeamAScore, match.TeamBScore))
+		return events
+	}
+
+	started := make(map[string]bool, len(old.Maps))
+	for _, game := range old.Maps {
+		started[game.UUID] = !game.ActualStartTime.IsZero()
+	}
+	for i, game := range match.Maps {
+		if game.ActualStartTime.IsZero() || !game.MatchEndedTime.IsZero() || started[game.UUID] {
+			continue
+		}
+		name := game.Name
+		if name == "" {
+			name = fmt.Sprintf("Game %d", i+1)
+		}
+		events = append(events, event(EventGameStarted, "%s started", name))
+	}
+	return events
+}
+
+// endedGames returns the decided games of a match that ended since the previous state, in the order they ended
+func endedGames(old, match domain.Match) []domain.MatchMap {
+	ended := make(map[string]bool, len(old.Maps))
+	for _, game := range old.Maps {
+		ended[game.UUID] = !game.MatchEndedTime.IsZero()
+	}
+
+	var games []domain.MatchMap
+	for _, game := range match.Maps {
+		if !game.MatchEndedTime.IsZero() && !ended[game.UUID] && game.TeamAScore != game.TeamBScore {
+			games = append(games, game)
+		}
+	}
+	sort.SliceStable(games, func(i, j int) bool {
+		return games[i].MatchEndedTime.Before(games[j].MatchEndedTime)
+	})
+	return games
+}
+
+// NextPoll returns the time to wait before the next poll: interval while a match is in progress, a longer
+// idle interval otherwise. While idle, polling resumes at interval when the next match is due to start.
+func NextPoll(matches []domain.Match, interval time.Duration, now time.Time) time.Duration {
+	idle := interval * idleFactor
+	for _, match := range matches {
+		if match.IsInProgress() {
+			return interval
+		}
+		if match.IsOver() || match.TimeOfSeries.IsZero() {
+			continue
+		}
+		if until := match.TimeOfSeries.Sub(now); until < idle {
+			idle = until
+		}
+	}
+	if idle < interval {
+		return interval
+	}
+	return idle
+}
+
+// Series names a match by its teams
+func Series(match domain.Match) string {
+	return teamName(match.TeamA) + " vs " + teamName(match.TeamB)
+}
+
+func teamName(team domain.MatchTeam) string {
+	if team.Name == "" {
+		return "TBD"
+	}
+	return team.Name
+}
@@ -0,0 +1,147 @@
+package watch
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/mgranderath/rlcs-cli/internal/api/blast"
+	"github.com/mgranderath/rlcs-cli/internal/domain"
+	"github.com/mgranderath/rlcs-cli/internal/mapper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var start = time.Date(2026, 3, 5, 18, 0, 0, 0, time.UTC)
+
+func series(scoreA, scoreB int, live, completed bool, games ...domain.MatchMap) domain.Match {
+	return domain.Match{
+		UUID:         "final",
+		Type:         "BO7",
+		TimeOfSeries: start,
+		TeamA:        domain.MatchTeam{UUID: "vit", Name: "Team Vitality"},
+		TeamB:        domain.MatchTeam{UUID: "kc", Name: "Karmine Corp"},
+		TeamAScore:   scoreA,
+		TeamBScore:   scoreB,
+		IsLive:       live,
+		IsCompleted:  completed,
+		Maps:         games,
+	}
+}
+
+func game(n int, started, ended bool) domain.MatchMap {
+	return scored(n, started, ended, 0, 0)
+}
+
+func scored(n int, started, ended bool, goalsA, goalsB int) domain.MatchMap {
+	g := domain.MatchMap{UUID: "g" + string(rune('0'+n)), Name: "Game " + string(rune('0'+n)), TeamAScore: goalsA, TeamBScore: goalsB}
+	if started {
+		g.ActualStartTime = start.Add(time.Duration(n) * 10 * time.Minute)
+	}
+	if ended {
+		g.MatchEndedTime = g.ActualStartTime.Add(7 * time.Minute)
+	}
+	return g
+}
+
+func messages(events []Event) []string {
+	var out []string
+	for _, e := range events {
+		out = append(out, e.Message)
+	}
+	return out
+}
+
+func TestDiff(t *testing.T) {
+	upcoming := series(0, 0, false, false, game(1, false, false))
+	live := series(0, 0, true, false, game(1, true, false))
+	assert.Equal(t, []string{"Series started", "Game 1 started"}, messages(Diff([]domain.Match{upcoming}, []domain.Match{live})))
+
+	before := series(2, 1, true, false, game(1, true, true), game(2, true, true), game(3, true, true), game(4, true, false))
+	after := series(3, 1, true, false, game(1, true, true), game(2, true, true), game(3, true, true), game(4, true, true), game(5, true, false))
+	events := Diff([]domain.Match{before}, []domain.Match{after})
+	assert.Equal(t, []string{"Team Vitality wins game 4 (3-1)", "Game 5 started"}, messages(events))
+	assert.Equal(t, "Team Vitality vs Karmine Corp: Team Vitality wins game 4 (3-1)", events[0].String())
+	assert.Equal(t, EventGameWon, events[0].Kind)
+
+	// Several games between two polls are reported one by one in the order they ended
+	over := series(4, 2, false, true, scored(5, true, true, 1, 3), scored(6, true, true, 2, 1))
+	assert.Equal(t, []string{
+		"Karmine Corp wins game 5 (3-2)",
+		"Team Vitality wins game 6 (4-2)",
+		"Series over 4-2",
+	}, messages(Diff([]domain.Match{series(3, 1, true, false)}, []domain.Match{over})))
+
+	// Without end times the games are credited from the series score, the leader wins the last one
+	over = series(4, 2, false, true)
+	assert.Equal(t, []string{
+		"Karmine Corp wins game 5 (3-2)",
+		"Team Vitality wins game 6 (4-2)",
+		"Series over 4-2",
+	}, messages(Diff([]domain.Match{series(3, 1, true, false)}, []domain.Match{over})))
+
+	// Unchanged and new matches produce no events
+	assert.Empty(t, Diff([]domain.Match{after}, []domain.Match{after}))
+	assert.Empty(t, Diff(nil, []domain.Match{after}))
+}
+
+func TestNextPoll(t *testing.T) {
+	interval := 30 * time.Second
+
+	assert.Equal(t, interval, NextPoll([]domain.Match{series(1, 0, true, false)}, interval, start))
+	assert.Equal(t, 5*time.Minute, NextPoll(nil, interval, start))
+	assert.Equal(t, 5*time.Minute, NextPoll([]domain.Match{series(0, 0, false, false)}, interval, start.Add(-time.Hour)))
+	assert.Equal(t, 2*time.Minute, NextPoll([]domain.Match{series(0, 0, false, false)}, interval, start.Add(-2*time.Minute)))
+	// Overdue matches that are not live yet are polled at interval
+	assert.Equal(t, interval, NextPoll([]domain.Match{series(0, 0, false, false)}, interval, start.Add(time.Minute)))
+	assert.Equal(t, 5*time.Minute, NextPoll([]domain.Match{series(4, 2, false, true)}, interval, start))
+}
+
+// mapped builds a best of 5 through the mapper, so that its status is inferred from the games like for
+// API responses. Each game is given as "live", "A" or "B" for the winner, games that are not listed have not started.
+func mapped(t *testing.T, games ...string) domain.Match {
+	t.Helper()
+	api := blast.MatchResponse{
+		ID:          "final",
+		Type:        "BO5",
+		ScheduledAt: start.Format(time.RFC3339),
+		TeamA:       blast.MatchResponseTeam{ID: "vit", Name: "Team Vitality"},
+		TeamB:       blast.MatchResponseTeam{ID: "kc", Name: "Karmine Corp"},
+	}
+	for i := 0; i < 5; i++ {
+		g := blast.MatchResponseMap{ID: fmt.Sprintf("g%d", i+1), Name: fmt.Sprintf("Game %d", i+1), ScheduledAt: start.Format(time.RFC3339)}
+		if i < len(games) {
+			g.StartedAt = start.Add(time.Duration(i) * 10 * time.Minute).Format(time.RFC3339)
+			switch games[i] {
+			case "A":
+				g.EndedAt, g.TeamAScore = start.Add(time.Duration(i)*10*time.Minute+7*time.Minute).Format(time.RFC3339), 1
+				api.TeamAScore++
+			case "B":
+				g.EndedAt, g.TeamBScore = start.Add(time.Duration(i)*10*time.Minute+7*time.Minute).Format(time.RFC3339), 1
+				api.TeamBScore++
+			}
+		}
+		api.Maps = append(api.Maps, g)
+	}
+
+	result := mapper.ToDomainMatchesFromResponse([]blast.MatchResponse{api})
+	require.Empty(t, result.Skipped)
+	require.Len(t, result.Items, 1)
+	return result.Items[0]
+}
+
+func TestDiff_BetweenGames(t *testing.T) {
+	between := mapped(t, "A")
+	// The mapper reports a series between two games as completed
+	require.True(t, between.IsCompleted)
+
+	assert.Equal(t, []string{"Series started", "Team Vitality wins game 1 (1-0)"},
+		messages(Diff([]domain.Match{mapped(t)}, []domain.Match{between})))
+	assert.Equal(t, []string{"Game 2 started"}, messages(Diff([]domain.Match{between}, []domain.Match{mapped(t, "A", "live")})))
+	assert.Equal(t, []string{"Team Vitality wins game 3 (3-0)", "Series over 3-0"},
+		messages(Diff([]domain.Match{mapped(t, "A", "A")}, []domain.Match{mapped(t, "A", "A", "A")})))
+
+	// Polling stays fast between games
+	assert.Equal(t, 30*time.Second, NextPoll([]domain.Match{between}, 30*time.Second, start.Add(time.Hour)))
+	assert.Equal(t, 5*time.Minute, NextPoll([]domain.Match{mapped(t, "A", "A", "A")}, 30*time.Second, start.Add(time.Hour)))
+}